@@ -1,5 +1,11 @@
 package go_pocket_sdk
 
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
 const (
 	ActionAdd         = "add"
 	ActionArchive     = "archive"
@@ -17,12 +23,128 @@ const (
 
 type Action struct {
 	Name   string `json:"action"`
-	ItemID string `json:"item_id"`
+	ItemID string `json:"item_id,omitempty"`
 	RefID  string `json:"ref_id,omitempty"`
 	Tags   string `json:"tags,omitempty"`
+	Tag    string `json:"tag,omitempty"`
 	Time   int64  `json:"time,omitempty"`
 	Title  string `json:"title,omitempty"`
 	URL    string `json:"url,omitempty"`
 	OldTag string `json:"old_tag,omitempty"`
 	NewTag string `json:"new_tag,omitempty"`
 }
+
+// AddActionOptions contains the optional data of the item added with NewAddAction
+type AddActionOptions struct {
+	Title string
+	Tags  []string
+	// RefID is a Twitter status id, used to associate the item with a tweet
+	RefID string
+	Time  time.Time
+}
+
+// NewAddAction creates an action that adds a new item to the Pocket list
+func NewAddAction(url string, opts AddActionOptions) Action {
+	action := Action{
+		Name:  ActionAdd,
+		URL:   url,
+		Title: opts.Title,
+		Tags:  strings.Join(opts.Tags, ","),
+		RefID: opts.RefID,
+	}
+
+	if !opts.Time.IsZero() {
+		action.Time = opts.Time.Unix()
+	}
+
+	return action
+}
+
+// NewArchiveAction creates an action that moves the item to the archive
+func NewArchiveAction(itemID string) Action {
+	return Action{Name: ActionArchive, ItemID: itemID}
+}
+
+// NewReAddAction creates an action that moves the item from the archive back to the list
+func NewReAddAction(itemID string) Action {
+	return Action{Name: ActionReAdd, ItemID: itemID}
+}
+
+// NewFavoriteAction creates an action that marks the item as a favorite
+func NewFavoriteAction(itemID string) Action {
+	return Action{Name: ActionFavorite, ItemID: itemID}
+}
+
+// NewUnFavoriteAction creates an action that removes the item from favorites
+func NewUnFavoriteAction(itemID string) Action {
+	return Action{Name: ActionUnFavorite, ItemID: itemID}
+}
+
+// NewDeleteAction creates an action that permanently deletes the item
+func NewDeleteAction(itemID string) Action {
+	return Action{Name: ActionDelete, ItemID: itemID}
+}
+
+// NewTagsAddAction creates an action that adds tags to the item
+func NewTagsAddAction(itemID string, tags ...string) Action {
+	return Action{Name: ActionTagsAdd, ItemID: itemID, Tags: strings.Join(tags, ",")}
+}
+
+// NewTagsRemoveAction creates an action that removes tags from the item
+func NewTagsRemoveAction(itemID string, tags ...string) Action {
+	return Action{Name: ActionTagsRemove, ItemID: itemID, Tags: strings.Join(tags, ",")}
+}
+
+// NewTagsReplaceAction creates an action that replaces all tags of the item
+func NewTagsReplaceAction(itemID string, tags ...string) Action {
+	return Action{Name: ActionTagsReplace, ItemID: itemID, Tags: strings.Join(tags, ",")}
+}
+
+// NewTagsClearAction creates an action that removes all tags from the item
+func NewTagsClearAction(itemID string) Action {
+	return Action{Name: ActionTagsClear, ItemID: itemID}
+}
+
+// NewTagRenameAction creates an action that renames the tag in all items of the user
+func NewTagRenameAction(oldTag, newTag string) Action {
+	return Action{Name: ActionTagRename, OldTag: oldTag, NewTag: newTag}
+}
+
+// NewTagDeleteAction creates an action that deletes the tag from all items of the user
+func NewTagDeleteAction(tag string) Action {
+	return Action{Name: ActionTagDelete, Tag: tag}
+}
+
+// Validate checks that the action has all the fields required by its type
+func (a Action) Validate() error {
+	switch a.Name {
+	case ActionAdd:
+		if a.URL == "" && a.ItemID == "" {
+			return ErrEmptyItemURL
+		}
+	case ActionArchive, ActionReAdd, ActionFavorite, ActionUnFavorite, ActionDelete, ActionTagsClear:
+		if a.ItemID == "" {
+			return ErrEmptyActionItemID
+		}
+	case ActionTagsAdd, ActionTagsRemove, ActionTagsReplace:
+		if a.ItemID == "" {
+			return ErrEmptyActionItemID
+		}
+
+		if a.Tags == "" {
+			return ErrEmptyActionTags
+		}
+	case ActionTagRename:
+		if a.OldTag == "" || a.NewTag == "" {
+			return ErrEmptyActionTag
+		}
+	case ActionTagDelete:
+		if a.Tag == "" {
+			return ErrEmptyActionTag
+		}
+	default:
+		return fmt.Errorf("%w: %q", ErrUnknownAction, a.Name)
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package go_pocket_sdk
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewActions(t *testing.T) {
+	addedAt := time.Date(2021, 9, 23, 15, 20, 35, 0, time.UTC)
+
+	testCases := []struct {
+		name     string
+		action   Action
+		expected Action
+	}{
+		{
+			name:     "Add",
+			action:   NewAddAction("https://github.com", AddActionOptions{Title: "GitHub", Tags: []string{"git", "code"}, RefID: "1", Time: addedAt}),
+			expected: Action{Name: ActionAdd, URL: "https://github.com", Title: "GitHub", Tags: "git,code", RefID: "1", Time: addedAt.Unix()},
+		},
+		{
+			name:     "Archive",
+			action:   NewArchiveAction("1"),
+			expected: Action{Name: ActionArchive, ItemID: "1"},
+		},
+		{
+			name:     "Tags add",
+			action:   NewTagsAddAction("1", "go", "sdk"),
+			expected: Action{Name: ActionTagsAdd, ItemID: "1", Tags: "go,sdk"},
+		},
+		{
+			name:     "Tag rename",
+			action:   NewTagRenameAction("golang", "go"),
+			expected: Action{Name: ActionTagRename, OldTag: "golang", NewTag: "go"},
+		},
+		{
+			name:     "Tag delete",
+			action:   NewTagDeleteAction("go"),
+			expected: Action{Name: ActionTagDelete, Tag: "go"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.action)
+			assert.NoError(t, tc.action.Validate())
+		})
+	}
+}
+
+func TestAction_Validate(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		action               Action
+		expectedErrorMessage string
+	}{
+		{
+			name:                 "Add without URL",
+			action:               NewAddAction("", AddActionOptions{}),
+			expectedErrorMessage: ErrEmptyItemURL.Error(),
+		},
+		{
+			name:                 "Archive without item ID",
+			action:               NewArchiveAction(""),
+			expectedErrorMessage: ErrEmptyActionItemID.Error(),
+		},
+		{
+			name:                 "Tags replace without tags",
+			action:               NewTagsReplaceAction("1"),
+			expectedErrorMessage: ErrEmptyActionTags.Error(),
+		},
+		{
+			name:                 "Tag rename without new tag",
+			action:               NewTagRenameAction("golang", ""),
+			expectedErrorMessage: ErrEmptyActionTag.Error(),
+		},
+		{
+			name:                 "Unknown action",
+			action:               Action{Name: "star", ItemID: "1"},
+			expectedErrorMessage: `unknown action: "star"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.EqualError(t, tc.action.Validate(), tc.expectedErrorMessage)
+		})
+	}
+}
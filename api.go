@@ -0,0 +1,17 @@
+package go_pocket_sdk
+
+import "context"
+
+// API is the set of Pocket API methods implemented by Client.
+// Depend on it instead of *Client to substitute the client in unit tests (see the pocketmock package)
+type API interface {
+	Add(ctx context.Context, input AddInput) (Item, error)
+	Modify(ctx context.Context, input ModifyInput) (ModifyResult, error)
+	Retrieving(ctx context.Context, input RetrievingInput) ([]Item, error)
+	Get(ctx context.Context, input RetrievingInput) (RetrieveResult, error)
+	Authorize(ctx context.Context, requestToken string) (Authorization, error)
+	GetRequestToken(ctx context.Context, redirectURL string, state string) (RequestToken, error)
+	GetAuthorizationURL(requestToken RequestToken) (string, error)
+}
+
+var _ API = (*Client)(nil)
@@ -0,0 +1,72 @@
+package go_pocket_sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthorizationURLBuilder(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		builder              *AuthorizationURLBuilder
+		expectedURL          string
+		expectedErrorMessage string
+		wantErr              bool
+	}{
+		{
+			name:        "OK",
+			builder:     NewAuthorizationURLBuilder(RequestToken{Code: "request-token", RedirectURL: "http://localhost"}),
+			expectedURL: "https://getpocket.com/auth/authorize?request_token=request-token&redirect_uri=http%3A%2F%2Flocalhost",
+			wantErr:     false,
+		},
+		{
+			name:        "OK_RedirectURLWithQuery",
+			builder:     NewAuthorizationURLBuilder(RequestToken{Code: "request-token", RedirectURL: "http://localhost/callback?user=1&next=/home"}),
+			expectedURL: "https://getpocket.com/auth/authorize?request_token=request-token&redirect_uri=http%3A%2F%2Flocalhost%2Fcallback%3Fuser%3D1%26next%3D%2Fhome",
+			wantErr:     false,
+		},
+		{
+			name:        "OK_MobileAndForce",
+			builder:     NewAuthorizationURLBuilder(RequestToken{Code: "request-token", RedirectURL: "http://localhost"}).Mobile().Force(ForceSignup),
+			expectedURL: "https://getpocket.com/auth/authorize?request_token=request-token&redirect_uri=http%3A%2F%2Flocalhost&mobile=1&force=signup",
+			wantErr:     false,
+		},
+		{
+			name:        "OK_CustomBaseURL",
+			builder:     NewAuthorizationURLBuilder(RequestToken{Code: "request-token", RedirectURL: "http://localhost", baseURL: "http://127.0.0.1:8080"}),
+			expectedURL: "http://127.0.0.1:8080/auth/authorize?request_token=request-token&redirect_uri=http%3A%2F%2Flocalhost",
+			wantErr:     false,
+		},
+		{
+			name:                 "Empty request token",
+			builder:              NewAuthorizationURLBuilder(RequestToken{RedirectURL: "http://localhost"}),
+			expectedErrorMessage: ErrEmptyRequestToken.Error(),
+			wantErr:              true,
+		},
+		{
+			name:                 "Invalid force",
+			builder:              NewAuthorizationURLBuilder(RequestToken{Code: "request-token", RedirectURL: "http://localhost"}).Force("always"),
+			expectedErrorMessage: ErrInvalidForce.Error(),
+			wantErr:              true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.builder.Build()
+			if tc.wantErr {
+				assert.Error(t, err)
+				assert.Equal(t, tc.expectedErrorMessage, err.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedURL, got)
+
+			u, err := tc.builder.URL()
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedURL, u.String())
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package go_pocket_sdk
+
+import (
+	"context"
+	"sync"
+)
+
+// BatchPolicy defines how Modify splits large lists of actions into several requests
+type BatchPolicy struct {
+	// Size is the maximum number of actions per request (0 disables splitting)
+	Size int
+	// Concurrency is the maximum number of requests sent at the same time (values less than 2 mean sequential sending)
+	Concurrency int
+	// ContinueOnError keeps sending the remaining batches after a failed request or a failed action,
+	// otherwise no new batches are sent after the first failure and their actions fail with ErrActionNotSent
+	ContinueOnError bool
+}
+
+// modifyInBatches sends the actions of the request in batches and aggregates the results in the original order.
+// It returns the first request error (in the order of batches) together with the results of all actions
+func (c *Client) modifyInBatches(ctx context.Context, req requestModify) (ModifyResult, error) {
+	actions := req.Actions
+	size := c.batchPolicy.Size
+
+	results := make([]ActionResult, len(actions))
+	for i, action := range actions {
+		results[i] = ActionResult{Action: action, Error: &ActionError{Message: ErrActionNotSent.Error(), Err: ErrActionNotSent}}
+	}
+
+	concurrency := c.batchPolicy.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		failed bool
+		sem    = make(chan struct{}, concurrency)
+		errs   = make([]error, (len(actions)+size-1)/size)
+	)
+
+	for n := range errs {
+		sem <- struct{}{}
+
+		mu.Lock()
+		stop := failed && !c.batchPolicy.ContinueOnError
+		mu.Unlock()
+
+		if stop || ctx.Err() != nil {
+			<-sem
+			break
+		}
+
+		start, end := n*size, (n+1)*size
+		if end > len(actions) {
+			end = len(actions)
+		}
+
+		wg.Add(1)
+		go func(n, start, end int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			batch := req
+			batch.Actions = actions[start:end]
+
+			ok := true
+			result, err := c.doHTTP(ctx, endpointModify, batch)
+			if err != nil {
+				errs[n] = err
+				ok = false
+				for i := start; i < end; i++ {
+					results[i].Error = &ActionError{Message: err.Error(), Err: err}
+				}
+			} else {
+				batchResult := parseModifyResult(batch.Actions, result)
+				copy(results[start:end], batchResult.Results)
+				ok = batchResult.Err() == nil
+			}
+
+			if !ok {
+				mu.Lock()
+				failed = true
+				mu.Unlock()
+			}
+		}(n, start, end)
+	}
+
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return ModifyResult{Results: results}, err
+		}
+	}
+
+	return ModifyResult{Results: results}, ctx.Err()
+}
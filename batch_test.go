@@ -0,0 +1,137 @@
+package go_pocket_sdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Modify_Batches(t *testing.T) {
+	testCases := []struct {
+		name             string
+		policy           BatchPolicy
+		itemIDs          []string
+		expectedSuccess  []bool
+		expectedNotSent  []bool
+		expectedRequests int32
+		wantErr          bool
+	}{
+		{
+			name:             "OK_Sequential",
+			policy:           BatchPolicy{Size: 3},
+			itemIDs:          []string{"1", "2", "3", "4", "5", "6", "7"},
+			expectedSuccess:  []bool{true, true, true, true, true, true, true},
+			expectedNotSent:  []bool{false, false, false, false, false, false, false},
+			expectedRequests: 3,
+			wantErr:          false,
+		},
+		{
+			name:             "OK_Concurrent",
+			policy:           BatchPolicy{Size: 2, Concurrency: 3, ContinueOnError: true},
+			itemIDs:          []string{"1", "2", "3", "bad", "5", "6", "7"},
+			expectedSuccess:  []bool{true, true, true, false, true, true, true},
+			expectedNotSent:  []bool{false, false, false, false, false, false, false},
+			expectedRequests: 4,
+			wantErr:          false,
+		},
+		{
+			name:             "OK_SingleBatch",
+			policy:           BatchPolicy{Size: 10},
+			itemIDs:          []string{"1", "2"},
+			expectedSuccess:  []bool{true, true},
+			expectedNotSent:  []bool{false, false},
+			expectedRequests: 1,
+			wantErr:          false,
+		},
+		{
+			name:             "Stop on failed action",
+			policy:           BatchPolicy{Size: 2},
+			itemIDs:          []string{"1", "bad", "3", "4"},
+			expectedSuccess:  []bool{true, false, false, false},
+			expectedNotSent:  []bool{false, false, true, true},
+			expectedRequests: 1,
+			wantErr:          false,
+		},
+		{
+			name:             "Stop on failed request",
+			policy:           BatchPolicy{Size: 2},
+			itemIDs:          []string{"1", "2", "fail", "4", "5"},
+			expectedSuccess:  []bool{true, true, false, false, false},
+			expectedNotSent:  []bool{false, false, false, false, true},
+			expectedRequests: 2,
+			wantErr:          true,
+		},
+		{
+			name:             "Continue on failed request",
+			policy:           BatchPolicy{Size: 2, ContinueOnError: true},
+			itemIDs:          []string{"1", "2", "fail", "4", "5"},
+			expectedSuccess:  []bool{true, true, false, false, true},
+			expectedNotSent:  []bool{false, false, false, false, false},
+			expectedRequests: 3,
+			wantErr:          true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// the stub fails the requests containing an action with the item ID "fail" and the actions with the item ID "bad"
+			var requests int32
+			client := newTestClient(t, func(r *http.Request) (*http.Response, error) {
+				atomic.AddInt32(&requests, 1)
+
+				var req requestModify
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+				assert.LessOrEqual(t, len(req.Actions), tc.policy.Size)
+
+				var actionResults, actionErrors []string
+				for _, action := range req.Actions {
+					switch action.ItemID {
+					case "fail":
+						return &http.Response{StatusCode: http.StatusServiceUnavailable, Body: io.NopCloser(strings.NewReader(""))}, nil
+					case "bad":
+						actionResults = append(actionResults, "false")
+						actionErrors = append(actionErrors, `{"message":"Invalid item id","type":"Bad Request","code":422}`)
+					default:
+						actionResults = append(actionResults, "true")
+						actionErrors = append(actionErrors, "null")
+					}
+				}
+
+				body := fmt.Sprintf(`{"status":1,"action_results":[%s],"action_errors":[%s]}`,
+					strings.Join(actionResults, ","), strings.Join(actionErrors, ","))
+				return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}, nil
+			}, WithBatchPolicy(tc.policy))
+
+			var actions []Action
+			for _, itemID := range tc.itemIDs {
+				actions = append(actions, Action{Name: ActionArchive, ItemID: itemID})
+			}
+
+			got, err := client.Modify(context.Background(), ModifyInput{AccessToken: "access-token", Actions: actions})
+			if tc.wantErr {
+				assert.True(t, IsServerError(err))
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tc.expectedRequests, requests)
+			assert.Len(t, got.Results, len(actions))
+			for i, result := range got.Results {
+				assert.Equal(t, actions[i], result.Action)
+				assert.Equal(t, tc.expectedSuccess[i], result.Success, "action %d", i)
+				assert.Equal(t, tc.expectedNotSent[i], result.Error != nil && errors.Is(result.Error, ErrActionNotSent), "action %d", i)
+				if tc.itemIDs[i] == "fail" {
+					assert.True(t, IsServerError(result.Error), "action %d", i)
+				}
+			}
+		})
+	}
+}
@@ -1,7 +1,9 @@
 package go_pocket_sdk
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
 )
 
 var (
@@ -13,4 +15,108 @@ var (
 	ErrEmptyItemURL                = fmt.Errorf("empty URL for add item")
 	ErrNoActions                   = fmt.Errorf("no actions to modify items")
 	ErrFailedToParseInputBody      = fmt.Errorf("failed to parse input body")
+	ErrNilHTTPClient               = fmt.Errorf("nil HTTP client")
+	ErrInvalidBaseURL              = fmt.Errorf("invalid base URL")
+	ErrInvalidTimeout              = fmt.Errorf("timeout must be positive")
+	ErrInvalidState                = fmt.Errorf("invalid state")
+	ErrInvalidFavorite             = fmt.Errorf("invalid favorite")
+	ErrInvalidContentType          = fmt.Errorf("invalid content type")
+	ErrInvalidSort                 = fmt.Errorf("invalid sort")
+	ErrInvalidDetailType           = fmt.Errorf("invalid detail type")
+	ErrNegativeCount               = fmt.Errorf("negative count")
+	ErrNegativeOffset              = fmt.Errorf("negative offset")
+	ErrOffsetWithoutCount          = fmt.Errorf("offset requires count")
+	ErrUnknownAction               = fmt.Errorf("unknown action")
+	ErrEmptyActionItemID           = fmt.Errorf("empty item ID of action")
+	ErrEmptyActionTags             = fmt.Errorf("empty tags of action")
+	ErrEmptyActionTag              = fmt.Errorf("empty tag of action")
+	ErrInvalidForce                = fmt.Errorf("invalid force option of authorization")
+	ErrStateMismatch               = fmt.Errorf("state of the authorization does not match the state of the request token")
+	ErrTokenNotFound               = fmt.Errorf("token not found")
+	ErrTokenFileDecryption         = fmt.Errorf("failed to decrypt the token file, the passphrase may be wrong")
+	ErrActionNotSent               = fmt.Errorf("action was not sent")
+	ErrNoResponse                  = fmt.Errorf("middleware returned neither a response nor an error")
 )
+
+// Error codes returned by Pocket in the X-Error-Code header (see https://getpocket.com/developer/docs/errors)
+const (
+	ErrorCodeInvalidAccessToken = 107
+	ErrorCodeMissingConsumerKey = 138
+	ErrorCodeInvalidConsumerKey = 152
+	ErrorCodeUserRejectedCode   = 158
+	ErrorCodeAlreadyUsedCode    = 159
+	ErrorCodeInvalidRedirectURI = 181
+	ErrorCodeMissingCode        = 182
+	ErrorCodeCodeNotFound       = 185
+	ErrorCodeServerIssue        = 199
+)
+
+// APIError is returned when the Pocket API responds with a non-200 status code
+type APIError struct {
+	StatusCode int
+	Code       int
+	Message    string
+	Endpoint   string
+	Header     http.Header
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("API error %d (HTTP %d): %s", e.Code, e.StatusCode, e.Message)
+}
+
+func (e *APIError) isAuthError() bool {
+	switch e.Code {
+	case ErrorCodeInvalidAccessToken, ErrorCodeMissingConsumerKey, ErrorCodeInvalidConsumerKey,
+		ErrorCodeUserRejectedCode, ErrorCodeAlreadyUsedCode, ErrorCodeMissingCode, ErrorCodeCodeNotFound:
+		return true
+	}
+
+	return e.StatusCode == http.StatusUnauthorized
+}
+
+func (e *APIError) isRateLimited() bool {
+	if e.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+
+	return e.StatusCode == http.StatusForbidden &&
+		(e.Header.Get(xLimitUserRemainingHeader) == "0" || e.Header.Get(xLimitKeyRemainingHeader) == "0")
+}
+
+func (e *APIError) isPermissionDenied() bool {
+	return e.StatusCode == http.StatusForbidden && !e.isAuthError() && !e.isRateLimited()
+}
+
+func (e *APIError) isServerError() bool {
+	return e.StatusCode >= http.StatusInternalServerError || e.Code == ErrorCodeServerIssue
+}
+
+// IsAuthError reports whether err is an APIError caused by an invalid consumer key, access token or request token
+func IsAuthError(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.isAuthError()
+}
+
+// IsRateLimited reports whether err is an APIError caused by exceeding the user or consumer key rate limit,
+// or a RateLimitError returned before sending the request
+func IsRateLimited(err error) bool {
+	var rateLimitErr *RateLimitError
+	if errors.As(err, &rateLimitErr) {
+		return true
+	}
+
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.isRateLimited()
+}
+
+// IsPermissionDenied reports whether err is an APIError caused by missing permissions of the application
+func IsPermissionDenied(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.isPermissionDenied()
+}
+
+// IsServerError reports whether err is an APIError caused by a problem on the Pocket side
+func IsServerError(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.isServerError()
+}
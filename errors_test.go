@@ -0,0 +1,97 @@
+package go_pocket_sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIError_Helpers(t *testing.T) {
+	testCases := []struct {
+		name                     string
+		err                      error
+		expectedAuthError        bool
+		expectedRateLimited      bool
+		expectedPermissionDenied bool
+		expectedServerError      bool
+	}{
+		{
+			name:              "Invalid access token",
+			err:               &APIError{StatusCode: 401, Code: ErrorCodeInvalidAccessToken},
+			expectedAuthError: true,
+		},
+		{
+			name:              "Invalid consumer key",
+			err:               &APIError{StatusCode: 403, Code: ErrorCodeInvalidConsumerKey},
+			expectedAuthError: true,
+		},
+		{
+			name:                "Rate limited by user limit",
+			err:                 &APIError{StatusCode: 403, Header: http.Header{xLimitUserRemainingHeader: {"0"}}},
+			expectedRateLimited: true,
+		},
+		{
+			name:                "Too many requests",
+			err:                 &APIError{StatusCode: 429},
+			expectedRateLimited: true,
+		},
+		{
+			name:                     "Permission denied",
+			err:                      &APIError{StatusCode: 403, Header: http.Header{xLimitUserRemainingHeader: {"10"}}},
+			expectedPermissionDenied: true,
+		},
+		{
+			name:                "Server issue",
+			err:                 &APIError{StatusCode: 503, Code: ErrorCodeServerIssue},
+			expectedServerError: true,
+		},
+		{
+			name:                "Wrapped API error",
+			err:                 fmt.Errorf("wrapped: %w", &APIError{StatusCode: 502}),
+			expectedServerError: true,
+		},
+		{
+			name: "Not an API error",
+			err:  errors.New("some error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedAuthError, IsAuthError(tc.err))
+			assert.Equal(t, tc.expectedRateLimited, IsRateLimited(tc.err))
+			assert.Equal(t, tc.expectedPermissionDenied, IsPermissionDenied(tc.err))
+			assert.Equal(t, tc.expectedServerError, IsServerError(tc.err))
+		})
+	}
+}
+
+func TestClient_doHTTP_APIError(t *testing.T) {
+	client := newTestClient(t, func(r *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusUnauthorized,
+			Header: http.Header{
+				xErrorCodeHeader: {"107"},
+				xErrorHeader:     {"Invalid access token"},
+			},
+			Body: io.NopCloser(strings.NewReader("")),
+		}, nil
+	})
+
+	_, err := client.Retrieving(context.Background(), RetrievingInput{AccessToken: "access-token"})
+
+	var apiErr *APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusUnauthorized, apiErr.StatusCode)
+	assert.Equal(t, ErrorCodeInvalidAccessToken, apiErr.Code)
+	assert.Equal(t, "Invalid access token", apiErr.Message)
+	assert.Equal(t, endpointRetrieving, apiErr.Endpoint)
+	assert.Equal(t, "API error 107 (HTTP 401): Invalid access token", err.Error())
+	assert.True(t, IsAuthError(err))
+}
@@ -1,7 +1,53 @@
 package go_pocket_sdk
 
 import (
+	"fmt"
 	"strings"
+	"time"
+)
+
+// State filters items by their status
+type State string
+
+const (
+	StateUnread  State = "unread"
+	StateArchive State = "archive"
+	StateAll     State = "all"
+)
+
+// Favorite filters items by their favorite status
+type Favorite string
+
+const (
+	FavoriteUnfavorited Favorite = "0"
+	FavoriteFavorited   Favorite = "1"
+)
+
+// ContentType filters items by their content
+type ContentType string
+
+const (
+	ContentTypeArticle ContentType = "article"
+	ContentTypeVideo   ContentType = "video"
+	ContentTypeImage   ContentType = "image"
+)
+
+// Sort defines the order of retrieved items
+type Sort string
+
+const (
+	SortNewest Sort = "newest"
+	SortOldest Sort = "oldest"
+	SortTitle  Sort = "title"
+	SortSite   Sort = "site"
+)
+
+// DetailType defines how much data is returned about each item
+type DetailType string
+
+const (
+	DetailTypeSimple   DetailType = "simple"
+	DetailTypeComplete DetailType = "complete"
 )
 
 // AddInput contains the data needed to create an item in the Pocket list
@@ -22,15 +68,15 @@ type ModifyInput struct {
 // RetrievingInput contains the data needed to retrieve items from the Pocket list
 type RetrievingInput struct {
 	AccessToken string
-	State       string
-	Favorite    string
+	State       State
+	Favorite    Favorite
 	Tag         string
-	ContentType string
-	Sort        string
-	DetailType  string
+	ContentType ContentType
+	Sort        Sort
+	DetailType  DetailType
 	Search      string
 	Domain      string
-	Since       int64
+	Since       time.Time
 	Count       int
 	Offset      int
 }
@@ -63,6 +109,12 @@ func (i ModifyInput) generateRequest(consumerKey string) (requestModify, error)
 		return requestModify{}, ErrNoActions
 	}
 
+	for n, action := range i.Actions {
+		if err := action.Validate(); err != nil {
+			return requestModify{}, fmt.Errorf("action %d: %w", n, err)
+		}
+	}
+
 	return requestModify{
 		ConsumerKey: consumerKey,
 		AccessToken: i.AccessToken,
@@ -70,23 +122,79 @@ func (i ModifyInput) generateRequest(consumerKey string) (requestModify, error)
 	}, nil
 }
 
+// Validate checks the filters of the input and returns a descriptive error for unknown values and invalid combinations
+func (i RetrievingInput) Validate() error {
+	switch i.State {
+	case "", StateUnread, StateArchive, StateAll:
+	default:
+		return fmt.Errorf("%w: %q", ErrInvalidState, i.State)
+	}
+
+	switch i.Favorite {
+	case "", FavoriteUnfavorited, FavoriteFavorited:
+	default:
+		return fmt.Errorf("%w: %q", ErrInvalidFavorite, i.Favorite)
+	}
+
+	switch i.ContentType {
+	case "", ContentTypeArticle, ContentTypeVideo, ContentTypeImage:
+	default:
+		return fmt.Errorf("%w: %q", ErrInvalidContentType, i.ContentType)
+	}
+
+	switch i.Sort {
+	case "", SortNewest, SortOldest, SortTitle, SortSite:
+	default:
+		return fmt.Errorf("%w: %q", ErrInvalidSort, i.Sort)
+	}
+
+	switch i.DetailType {
+	case "", DetailTypeSimple, DetailTypeComplete:
+	default:
+		return fmt.Errorf("%w: %q", ErrInvalidDetailType, i.DetailType)
+	}
+
+	if i.Count < 0 {
+		return fmt.Errorf("%w: %d", ErrNegativeCount, i.Count)
+	}
+
+	if i.Offset < 0 {
+		return fmt.Errorf("%w: %d", ErrNegativeOffset, i.Offset)
+	}
+
+	if i.Offset > 0 && i.Count == 0 {
+		return ErrOffsetWithoutCount
+	}
+
+	return nil
+}
+
 func (i RetrievingInput) generateRequest(consumerKey string) (requestRetrieving, error) {
 	if i.AccessToken == "" {
 		return requestRetrieving{}, ErrEmptyAccessToken
 	}
 
+	if err := i.Validate(); err != nil {
+		return requestRetrieving{}, err
+	}
+
+	var since int64
+	if !i.Since.IsZero() {
+		since = i.Since.Unix()
+	}
+
 	return requestRetrieving{
 		ConsumerKey: consumerKey,
 		AccessToken: i.AccessToken,
-		State:       i.State,
-		Favorite:    i.Favorite,
+		State:       string(i.State),
+		Favorite:    string(i.Favorite),
 		Tag:         i.Tag,
-		ContentType: i.ContentType,
-		Sort:        i.Sort,
-		DetailType:  i.DetailType,
+		ContentType: string(i.ContentType),
+		Sort:        string(i.Sort),
+		DetailType:  string(i.DetailType),
 		Search:      i.Search,
 		Domain:      i.Domain,
-		Since:       i.Since,
+		Since:       since,
 		Count:       i.Count,
 		Offset:      i.Offset,
 	}, nil
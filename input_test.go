@@ -0,0 +1,111 @@
+package go_pocket_sdk
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetrievingInput_Validate(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		input                RetrievingInput
+		expectedErrorMessage string
+		wantErr              bool
+	}{
+		{
+			name: "OK_AllFilters",
+			input: RetrievingInput{
+				State:       StateAll,
+				Favorite:    FavoriteFavorited,
+				ContentType: ContentTypeVideo,
+				Sort:        SortNewest,
+				DetailType:  DetailTypeComplete,
+				Count:       10,
+				Offset:      20,
+			},
+			wantErr: false,
+		},
+		{
+			name:    "OK_Empty",
+			input:   RetrievingInput{},
+			wantErr: false,
+		},
+		{
+			name:                 "Unknown state",
+			input:                RetrievingInput{State: "read"},
+			expectedErrorMessage: `invalid state: "read"`,
+			wantErr:              true,
+		},
+		{
+			name:                 "Unknown favorite",
+			input:                RetrievingInput{Favorite: "yes"},
+			expectedErrorMessage: `invalid favorite: "yes"`,
+			wantErr:              true,
+		},
+		{
+			name:                 "Unknown content type",
+			input:                RetrievingInput{ContentType: "audio"},
+			expectedErrorMessage: `invalid content type: "audio"`,
+			wantErr:              true,
+		},
+		{
+			name:                 "Unknown sort",
+			input:                RetrievingInput{Sort: "latest"},
+			expectedErrorMessage: `invalid sort: "latest"`,
+			wantErr:              true,
+		},
+		{
+			name:                 "Unknown detail type",
+			input:                RetrievingInput{DetailType: "full"},
+			expectedErrorMessage: `invalid detail type: "full"`,
+			wantErr:              true,
+		},
+		{
+			name:                 "Negative count",
+			input:                RetrievingInput{Count: -1},
+			expectedErrorMessage: "negative count: -1",
+			wantErr:              true,
+		},
+		{
+			name:                 "Negative offset",
+			input:                RetrievingInput{Count: 10, Offset: -1},
+			expectedErrorMessage: "negative offset: -1",
+			wantErr:              true,
+		},
+		{
+			name:                 "Offset without count",
+			input:                RetrievingInput{Offset: 10},
+			expectedErrorMessage: ErrOffsetWithoutCount.Error(),
+			wantErr:              true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.input.Validate()
+			if tc.wantErr {
+				assert.Error(t, err)
+				assert.Equal(t, tc.expectedErrorMessage, err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRetrievingInput_generateRequest(t *testing.T) {
+	since := time.Date(2021, 9, 23, 15, 20, 35, 0, time.UTC)
+
+	req, err := RetrievingInput{AccessToken: "access-token", Since: since}.generateRequest("consumer-key")
+	assert.NoError(t, err)
+	assert.Equal(t, since.Unix(), req.Since)
+
+	req, err = RetrievingInput{AccessToken: "access-token"}.generateRequest("consumer-key")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), req.Since)
+
+	_, err = RetrievingInput{AccessToken: "access-token", Sort: "latest"}.generateRequest("consumer-key")
+	assert.ErrorIs(t, err, ErrInvalidSort)
+}
@@ -0,0 +1,119 @@
+package go_pocket_sdk
+
+import (
+	"context"
+)
+
+const defaultPageSize = 30
+
+// ItemIterator walks through the Pocket list page by page, fetching each page lazily.
+//
+//	it := client.Items(ctx, pocket.RetrievingInput{AccessToken: accessToken}, pocket.WithPageSize(100))
+//	for it.Next() {
+//		item := it.Item()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type ItemIterator struct {
+	ctx      context.Context
+	client   *Client
+	input    RetrievingInput
+	pageSize int
+	maxItems int
+
+	page    []Item
+	pos     int
+	item    Item
+	yielded int
+	done    bool
+	err     error
+}
+
+// IteratorOption configures the ItemIterator created by Client.Items
+type IteratorOption func(it *ItemIterator)
+
+// WithPageSize sets the number of items requested per page (default is RetrievingInput.Count or 30)
+func WithPageSize(pageSize int) IteratorOption {
+	return func(it *ItemIterator) {
+		if pageSize > 0 {
+			it.pageSize = pageSize
+		}
+	}
+}
+
+// WithMaxItems stops the iteration after the given number of items
+func WithMaxItems(maxItems int) IteratorOption {
+	return func(it *ItemIterator) {
+		it.maxItems = maxItems
+	}
+}
+
+// Items returns an iterator over all items matching the input, starting from RetrievingInput.Offset
+func (c *Client) Items(ctx context.Context, input RetrievingInput, opts ...IteratorOption) *ItemIterator {
+	it := &ItemIterator{
+		ctx:      ctx,
+		client:   c,
+		input:    input,
+		pageSize: input.Count,
+	}
+
+	if it.pageSize <= 0 {
+		it.pageSize = defaultPageSize
+	}
+
+	for _, opt := range opts {
+		opt(it)
+	}
+
+	return it
+}
+
+// Next advances the iterator to the next item, fetching the next page if needed.
+// It returns false when there are no more items or an error occurred (see Err)
+func (it *ItemIterator) Next() bool {
+	if it.done || it.maxItems > 0 && it.yielded >= it.maxItems {
+		it.done = true
+		return false
+	}
+
+	if it.pos >= len(it.page) && !it.fetch() {
+		it.done = true
+		return false
+	}
+
+	it.item = it.page[it.pos]
+	it.pos++
+	it.yielded++
+	return true
+}
+
+// Item returns the current item
+func (it *ItemIterator) Item() Item {
+	return it.item
+}
+
+// Err returns the error that stopped the iteration, if any
+func (it *ItemIterator) Err() error {
+	return it.err
+}
+
+func (it *ItemIterator) fetch() bool {
+	input := it.input
+	input.Count = it.pageSize
+	if it.maxItems > 0 && it.maxItems-it.yielded < input.Count {
+		input.Count = it.maxItems - it.yielded
+	}
+
+	items, err := it.client.Retrieving(it.ctx, input)
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	it.input.Offset += len(items)
+	it.page = items
+	it.pos = 0
+	return len(items) > 0
+}
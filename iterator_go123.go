@@ -0,0 +1,27 @@
+//go:build go1.23
+
+package go_pocket_sdk
+
+import (
+	"iter"
+)
+
+// All returns the remaining items of the iterator for use with range-over-func.
+// If an error stops the iteration, it is yielded last together with an empty Item
+//
+//	for item, err := range client.Items(ctx, input).All() {
+//		...
+//	}
+func (it *ItemIterator) All() iter.Seq2[Item, error] {
+	return func(yield func(Item, error) bool) {
+		for it.Next() {
+			if !yield(it.Item(), nil) {
+				return
+			}
+		}
+
+		if it.err != nil {
+			yield(Item{}, it.err)
+		}
+	}
+}
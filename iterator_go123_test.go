@@ -0,0 +1,33 @@
+//go:build go1.23
+
+package go_pocket_sdk
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestItemIterator_All(t *testing.T) {
+	var calls int
+	client := newTestClient(t, scriptedTransport(&calls,
+		stubResponse{statusCode: http.StatusOK, body: `{"status":1,"list":{"0":{"item_id":"0"},"1":{"item_id":"1"}}}`},
+		stubResponse{statusCode: http.StatusOK, body: `{"status":1,"list":{"2":{"item_id":"2"},"3":{"item_id":"3"}}}`},
+		stubResponse{statusCode: http.StatusServiceUnavailable},
+	))
+
+	var ids []string
+	var lastErr error
+	for item, err := range client.Items(context.Background(), RetrievingInput{AccessToken: "access-token"}, WithPageSize(2)).All() {
+		if err != nil {
+			lastErr = err
+			break
+		}
+		ids = append(ids, item.ID)
+	}
+
+	assert.Equal(t, []string{"0", "1", "2", "3"}, ids)
+	assert.True(t, IsServerError(lastErr))
+}
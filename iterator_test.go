@@ -0,0 +1,112 @@
+package go_pocket_sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Items(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		total                int
+		failOn               int
+		input                RetrievingInput
+		opts                 []IteratorOption
+		expectedCount        int
+		expectedRequests     int
+		expectedErrorMessage string
+	}{
+		{
+			name:             "OK_AllPages",
+			total:            7,
+			input:            RetrievingInput{AccessToken: "access-token"},
+			opts:             []IteratorOption{WithPageSize(3)},
+			expectedCount:    7,
+			expectedRequests: 4,
+		},
+		{
+			name:             "OK_PageSizeFromCount",
+			total:            7,
+			input:            RetrievingInput{AccessToken: "access-token", Count: 5},
+			expectedCount:    7,
+			expectedRequests: 3,
+		},
+		{
+			name:             "OK_MaxItems",
+			total:            7,
+			input:            RetrievingInput{AccessToken: "access-token"},
+			opts:             []IteratorOption{WithPageSize(3), WithMaxItems(4)},
+			expectedCount:    4,
+			expectedRequests: 2,
+		},
+		{
+			name:             "OK_Offset",
+			total:            7,
+			input:            RetrievingInput{AccessToken: "access-token", Offset: 5},
+			expectedCount:    2,
+			expectedRequests: 2,
+		},
+		{
+			name:                 "Error on second page",
+			total:                7,
+			failOn:               2,
+			input:                RetrievingInput{AccessToken: "access-token"},
+			opts:                 []IteratorOption{WithPageSize(3)},
+			expectedCount:        3,
+			expectedRequests:     2,
+			expectedErrorMessage: "API error 0 (HTTP 503): ",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// the stub serves tc.total items page by page, failing with 503 on the request with number tc.failOn
+			var requests []requestRetrieving
+			client := newTestClient(t, func(r *http.Request) (*http.Response, error) {
+				var req requestRetrieving
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+				requests = append(requests, req)
+
+				if len(requests) == tc.failOn {
+					return &http.Response{StatusCode: http.StatusServiceUnavailable, Body: io.NopCloser(strings.NewReader(""))}, nil
+				}
+
+				var list []string
+				for i := req.Offset; i < tc.total && i < req.Offset+req.Count; i++ {
+					list = append(list, fmt.Sprintf(`"%d":{"item_id":"%d","sort_id":%d}`, i, i, i-req.Offset))
+				}
+
+				body := fmt.Sprintf(`{"status":1,"list":{%s}}`, strings.Join(list, ","))
+				return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}, nil
+			})
+
+			it := client.Items(context.Background(), tc.input, tc.opts...)
+
+			var ids []string
+			for it.Next() {
+				ids = append(ids, it.Item().ID)
+			}
+
+			assert.Len(t, ids, tc.expectedCount)
+			assert.Len(t, requests, tc.expectedRequests)
+			for i, id := range ids {
+				assert.Equal(t, fmt.Sprint(tc.input.Offset+i), id)
+			}
+
+			if tc.expectedErrorMessage != "" {
+				assert.EqualError(t, it.Err(), tc.expectedErrorMessage)
+			} else {
+				assert.NoError(t, it.Err())
+			}
+
+			assert.False(t, it.Next())
+		})
+	}
+}
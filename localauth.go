@@ -0,0 +1,145 @@
+package go_pocket_sdk
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+)
+
+const (
+	localAuthAddr         = "127.0.0.1:0"
+	localAuthCallbackPath = "/callback/"
+	localAuthStateSize    = 16
+
+	localAuthSuccessPage = `<!DOCTYPE html><html><body><p>Authorization completed, you can close this window.</p></body></html>`
+)
+
+// LocalAuth is the authorization flow for CLIs and desktop tools, which receives the redirect from Pocket
+// on a loopback HTTP listener instead of a web application.
+//
+//	flow, err := client.StartLocalAuth(ctx)
+//	if err != nil {
+//		...
+//	}
+//	defer flow.Close()
+//
+//	fmt.Println("Open in your browser:", flow.AuthorizationURL())
+//
+//	auth, err := flow.Wait(ctx)
+type LocalAuth struct {
+	client       *Client
+	requestToken RequestToken
+	authURL      string
+	server       *http.Server
+	redirected   chan struct{}
+	closeOnce    sync.Once
+}
+
+// StartLocalAuth starts a loopback HTTP listener and obtains a request token with the listener as the redirect URL.
+// The redirect URL contains a random state value, which is verified when the user is redirected back and after the authorization
+func (c *Client) StartLocalAuth(ctx context.Context) (*LocalAuth, error) {
+	state, err := newState()
+	if err != nil {
+		return nil, err
+	}
+
+	listener, err := net.Listen("tcp", localAuthAddr)
+	if err != nil {
+		return nil, fmt.Errorf("error occurred when starting the callback listener: %w", err)
+	}
+
+	redirectURL := fmt.Sprintf("http://%s%s%s", listener.Addr(), localAuthCallbackPath, state)
+
+	requestToken, err := c.GetRequestToken(ctx, redirectURL, state)
+	if err != nil {
+		listener.Close()
+		return nil, err
+	}
+
+	authURL, err := requestToken.AuthorizationURL()
+	if err != nil {
+		listener.Close()
+		return nil, err
+	}
+
+	flow := &LocalAuth{
+		client:       c,
+		requestToken: requestToken,
+		authURL:      authURL,
+		redirected:   make(chan struct{}),
+	}
+
+	var once sync.Once
+	mux := http.NewServeMux()
+	mux.HandleFunc(localAuthCallbackPath, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != localAuthCallbackPath+state {
+			http.Error(w, "invalid state", http.StatusForbidden)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		fmt.Fprint(w, localAuthSuccessPage)
+		once.Do(func() { close(flow.redirected) })
+	})
+
+	flow.server = &http.Server{Handler: mux}
+	go flow.server.Serve(listener)
+
+	return flow, nil
+}
+
+// AuthorizationURL returns the URL the user has to open in a browser to grant access rights
+func (a *LocalAuth) AuthorizationURL() string {
+	return a.authURL
+}
+
+// RequestToken returns the request token of the flow
+func (a *LocalAuth) RequestToken() RequestToken {
+	return a.requestToken
+}
+
+// Wait waits until the user is redirected back from Pocket (or the context is done) and then authorizes the request token.
+// The listener is closed when Wait returns
+func (a *LocalAuth) Wait(ctx context.Context) (Authorization, error) {
+	defer a.Close()
+
+	select {
+	case <-ctx.Done():
+		return Authorization{}, ctx.Err()
+	case <-a.redirected:
+	}
+
+	auth, err := a.client.Authorize(ctx, a.requestToken.Code)
+	if err != nil {
+		return Authorization{}, err
+	}
+
+	if auth.State != a.requestToken.State {
+		return Authorization{}, ErrStateMismatch
+	}
+
+	return auth, nil
+}
+
+// Close stops the listener
+func (a *LocalAuth) Close() error {
+	var err error
+	a.closeOnce.Do(func() {
+		err = a.server.Close()
+	})
+
+	return err
+}
+
+func newState() (string, error) {
+	b := make([]byte, localAuthStateSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("error occurred when generating the state: %w", err)
+	}
+
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,89 @@
+package go_pocket_sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_StartLocalAuth(t *testing.T) {
+	// the stub echoes the state of the request token (or returns returnedState, if it is not empty)
+	var state, returnedState string
+	client := newTestClient(t, func(r *http.Request) (*http.Response, error) {
+		var body string
+		switch r.URL.Path {
+		case endpointRequestToken:
+			var req requestToken
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			state = req.State
+			body = `{"code":"request-token"}`
+		case endpointRequestAuthorize:
+			if returnedState != "" {
+				state = returnedState
+			}
+			body = fmt.Sprintf(`{"access_token":"access-token","username":"pocket-user","state":%q}`, state)
+		}
+
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}, nil
+	})
+
+	t.Run("OK", func(t *testing.T) {
+		returnedState = ""
+		flow, err := client.StartLocalAuth(context.Background())
+		assert.NoError(t, err)
+
+		redirectURL := flow.RequestToken().RedirectURL
+		assert.True(t, strings.HasPrefix(redirectURL, "http://127.0.0.1:"))
+		assert.Contains(t, flow.AuthorizationURL(), "request_token=request-token")
+
+		resp, err := http.Get(redirectURL[:strings.LastIndex(redirectURL, "/")+1] + "forged-state")
+		assert.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+		resp, err = http.Get(redirectURL)
+		assert.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		auth, err := flow.Wait(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "access-token", auth.AccessToken)
+		assert.Equal(t, flow.RequestToken().State, auth.State)
+
+		_, err = http.Get(redirectURL)
+		assert.Error(t, err)
+	})
+
+	t.Run("State mismatch", func(t *testing.T) {
+		returnedState = "forged-state"
+		flow, err := client.StartLocalAuth(context.Background())
+		assert.NoError(t, err)
+
+		resp, err := http.Get(flow.RequestToken().RedirectURL)
+		assert.NoError(t, err)
+		resp.Body.Close()
+
+		_, err = flow.Wait(context.Background())
+		assert.ErrorIs(t, err, ErrStateMismatch)
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		returnedState = ""
+		flow, err := client.StartLocalAuth(context.Background())
+		assert.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		_, err = flow.Wait(ctx)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
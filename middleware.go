@@ -0,0 +1,83 @@
+package go_pocket_sdk
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/tidwall/gjson"
+)
+
+// Request is a request to the Pocket API passed through the middleware chain.
+// A new Request with its own copy of the body is created for each attempt, so middleware may change it without affecting the retries
+type Request struct {
+	// Endpoint is the path of the API method, e.g. "/v3/get"
+	Endpoint string
+	// Body is the marshaled JSON body of the request
+	Body []byte
+	// Header is sent with the request, middleware may add its own headers (e.g. for a proxy)
+	Header http.Header
+
+	accessToken string
+}
+
+// Response is a response of the Pocket API passed back through the middleware chain
+type Response struct {
+	// HTTP is the received response, its body has already been read into Body.
+	// It is nil if the response was made by middleware without sending the request
+	HTTP *http.Response
+	// Body is the raw body of the response
+	Body []byte
+	// Result is the parsed body, if middleware leaves it empty, it is parsed from Body
+	Result gjson.Result
+}
+
+// parse fills Result from Body unless it is already set, failing if Body is not a JSON value
+func (r *Response) parse() error {
+	if r.Result.Exists() {
+		return nil
+	}
+
+	if !gjson.ValidBytes(r.Body) {
+		return ErrFailedToParseInputBody
+	}
+
+	r.Result = gjson.ParseBytes(r.Body)
+	if r.Result.String() == "" {
+		return ErrFailedToParseInputBody
+	}
+
+	return nil
+}
+
+// Handler sends a request to the Pocket API, the returned Response is non-nil whenever a response was received, even with an error
+type Handler func(ctx context.Context, req *Request) (*Response, error)
+
+// Middleware wraps a Handler to add behavior around each request (auditing, metrics, extra headers, etc).
+// Middleware may return a Response without calling next to short-circuit the request
+type Middleware func(next Handler) Handler
+
+// handler returns the send pipeline of the client wrapped into its middleware, the first middleware is the outermost
+func (c *Client) handler() Handler {
+	h := Handler(c.send)
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		h = c.middleware[i](h)
+	}
+
+	return h
+}
+
+func (c *Client) newRequest(endpoint, accessToken string, body []byte) *Request {
+	header := make(http.Header)
+	header.Set("Content-Type", "application/json; charset=UTF-8")
+	header.Set("X-Accept", "application/json")
+	if c.userAgent != "" {
+		header.Set("User-Agent", c.userAgent)
+	}
+
+	return &Request{
+		Endpoint:    endpoint,
+		Body:        append([]byte(nil), body...),
+		Header:      header,
+		accessToken: accessToken,
+	}
+}
@@ -0,0 +1,165 @@
+package go_pocket_sdk
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+)
+
+func TestClient_Middleware(t *testing.T) {
+	var calls []string
+	trace := func(name string) Middleware {
+		return func(next Handler) Handler {
+			return func(ctx context.Context, req *Request) (*Response, error) {
+				calls = append(calls, name+" "+req.Endpoint)
+				resp, err := next(ctx, req)
+				if resp != nil && resp.HTTP != nil {
+					calls = append(calls, name+" "+resp.HTTP.Status)
+				}
+
+				return resp, err
+			}
+		}
+	}
+
+	proxyAuth := func(next Handler) Handler {
+		return func(ctx context.Context, req *Request) (*Response, error) {
+			assert.JSONEq(t, `{"consumer_key":"consumer-key","redirect_uri":"http://localhost"}`, string(req.Body))
+			req.Header.Set("Proxy-Authorization", "Bearer proxy-token")
+			return next(ctx, req)
+		}
+	}
+
+	client := newTestClient(t, func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "Bearer proxy-token", r.Header.Get("Proxy-Authorization"))
+		assert.Equal(t, "application/json", r.Header.Get("X-Accept"))
+
+		return &http.Response{
+			Status:     "200 OK",
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"code":"request-token"}`)),
+		}, nil
+	}, WithMiddleware(trace("first"), trace("second"), proxyAuth))
+
+	token, err := client.GetRequestToken(context.Background(), "http://localhost", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "request-token", token.Code)
+	assert.Equal(t, []string{"first /v3/oauth/request", "second /v3/oauth/request", "second 200 OK", "first 200 OK"}, calls)
+}
+
+func TestClient_Middleware_ShortCircuit(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		response             *Response
+		expectedCode         string
+		expectedErrorMessage string
+		wantErr              bool
+	}{
+		{
+			name:         "OK result",
+			response:     &Response{Result: gjson.Parse(`{"code":"cached-token"}`)},
+			expectedCode: "cached-token",
+		},
+		{
+			name:         "OK body",
+			response:     &Response{Body: []byte(`{"code":"cached-token"}`)},
+			expectedCode: "cached-token",
+		},
+		{
+			name:                 "malformed body",
+			response:             &Response{Body: []byte(`{"code":`)},
+			expectedErrorMessage: ErrFailedToParseInputBody.Error(),
+			wantErr:              true,
+		},
+		{
+			name:                 "no response",
+			expectedErrorMessage: ErrNoResponse.Error(),
+			wantErr:              true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := newTestClient(t, func(r *http.Request) (*http.Response, error) {
+				t.Fatal("the request must not be sent")
+				return nil, nil
+			}, WithMiddleware(func(next Handler) Handler {
+				return func(ctx context.Context, req *Request) (*Response, error) {
+					return tc.response, nil
+				}
+			}))
+
+			token, err := client.GetRequestToken(context.Background(), "http://localhost", "")
+			if tc.wantErr {
+				assert.EqualError(t, err, tc.expectedErrorMessage)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedCode, token.Code)
+			}
+		})
+	}
+}
+
+func TestClient_Middleware_APIError(t *testing.T) {
+	var status int
+	client := newTestClient(t, func(r *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusForbidden,
+			Header:     http.Header{xErrorCodeHeader: []string{"152"}},
+			Body:       io.NopCloser(strings.NewReader("")),
+		}, nil
+	}, WithMiddleware(func(next Handler) Handler {
+		return func(ctx context.Context, req *Request) (*Response, error) {
+			resp, err := next(ctx, req)
+			if resp != nil {
+				status = resp.HTTP.StatusCode
+			}
+
+			return resp, err
+		}
+	}))
+
+	_, err := client.GetRequestToken(context.Background(), "http://localhost", "")
+	assert.True(t, IsAuthError(err))
+	assert.Equal(t, http.StatusForbidden, status)
+}
+
+func TestClient_Middleware_BodyPerAttempt(t *testing.T) {
+	var attempts int
+	var bodies []string
+	client := newTestClient(t, func(r *http.Request) (*http.Response, error) {
+		b, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		bodies = append(bodies, string(b))
+
+		if len(bodies) == 1 {
+			return &http.Response{StatusCode: http.StatusServiceUnavailable, Body: io.NopCloser(strings.NewReader(""))}, nil
+		}
+
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{"status":1,"list":{}}`))}, nil
+	}, WithRetryPolicy(RetryPolicy{MaxAttempts: 2}), WithMiddleware(func(next Handler) Handler {
+		return func(ctx context.Context, req *Request) (*Response, error) {
+			// overwrite the body of the first attempt in place, the next attempt must get the original one
+			attempts++
+			if attempts == 1 {
+				for i := range req.Body {
+					req.Body[i] = ' '
+				}
+			}
+
+			return next(ctx, req)
+		}
+	}))
+
+	_, err := client.Retrieving(context.Background(), RetrievingInput{AccessToken: "access-token"})
+	assert.NoError(t, err)
+	if assert.Len(t, bodies, 2) {
+		assert.Empty(t, strings.TrimSpace(bodies[0]))
+		assert.Contains(t, bodies[1], `"access_token":"access-token"`)
+	}
+}
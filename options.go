@@ -0,0 +1,89 @@
+package go_pocket_sdk
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Option configures the Client created by NewClient
+type Option func(c *Client) error
+
+// WithHTTPClient sets the http.Client used to send requests to the Pocket API (for example, with a custom transport or proxy)
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) error {
+		if httpClient == nil {
+			return ErrNilHTTPClient
+		}
+
+		c.client = httpClient
+		return nil
+	}
+}
+
+// WithBaseURL sets the base URL of the Pocket server (default is https://getpocket.com), which is useful for local stand-ins
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) error {
+		u, err := url.Parse(baseURL)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return ErrInvalidBaseURL
+		}
+
+		c.baseURL = strings.TrimRight(baseURL, "/")
+		return nil
+	}
+}
+
+// WithTimeout sets the timeout of each request to the Pocket API regardless of the order of options.
+// The http.Client passed to WithHTTPClient is not modified, a copy of it is used instead
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) error {
+		if timeout <= 0 {
+			return ErrInvalidTimeout
+		}
+
+		c.timeout = timeout
+		return nil
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with each request to the Pocket API
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) error {
+		c.userAgent = userAgent
+		return nil
+	}
+}
+
+// WithRateLimitPolicy sets what the client does before sending a request that would exceed the remaining rate limit
+func WithRateLimitPolicy(policy RateLimitPolicy) Option {
+	return func(c *Client) error {
+		c.rateLimitPolicy = policy
+		return nil
+	}
+}
+
+// WithRetryPolicy sets the policy for retrying requests that failed because of transient errors (by default requests are not retried)
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) error {
+		c.retryPolicy = policy
+		return nil
+	}
+}
+
+// WithBatchPolicy sets how Modify splits large lists of actions into several requests (by default all actions are sent at once)
+func WithBatchPolicy(policy BatchPolicy) Option {
+	return func(c *Client) error {
+		c.batchPolicy = policy
+		return nil
+	}
+}
+
+// WithMiddleware adds middleware around each request sent to the Pocket API, the first middleware is the outermost
+func WithMiddleware(middleware ...Middleware) Option {
+	return func(c *Client) error {
+		c.middleware = append(c.middleware, middleware...)
+		return nil
+	}
+}
@@ -7,20 +7,21 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"sort"
+	"strconv"
 	"time"
 
 	"github.com/tidwall/gjson"
 )
 
 const (
-	host         = "https://getpocket.com/v3"
-	authorizeURL = "https://getpocket.com/auth/authorize?request_token=%s&redirect_uri=%s"
+	defaultBaseURL = "https://getpocket.com"
 
-	endpointAdd              = "/add"
-	endpointModify           = "/send"
-	endpointRetrieving       = "/get"
-	endpointRequestToken     = "/oauth/request"
-	endpointRequestAuthorize = "/oauth/authorize"
+	endpointAdd              = "/v3/add"
+	endpointModify           = "/v3/send"
+	endpointRetrieving       = "/v3/get"
+	endpointRequestToken     = "/v3/oauth/request"
+	endpointRequestAuthorize = "/v3/oauth/authorize"
 
 	xErrorHeader     = "X-Error"
 	xErrorCodeHeader = "X-Error-Code"
@@ -30,68 +31,144 @@ const (
 
 // Client is a getpocket API client
 type Client struct {
-	client      *http.Client
-	consumerKey string
-	redirectURL string
+	client          *http.Client
+	baseURL         string
+	userAgent       string
+	consumerKey     string
+	timeout         time.Duration
+	rateLimitPolicy RateLimitPolicy
+	retryPolicy     RetryPolicy
+	batchPolicy     BatchPolicy
+	limits          rateLimiter
+	middleware      []Middleware
 }
 
-// NewClient creates a new client with your application key (to generate a key, create your application here: https://getpocket.com/developer/apps)
-func NewClient(consumerKey string) (*Client, error) {
+// NewClient creates a new client with your application key (to generate a key, create your application here: https://getpocket.com/developer/apps).
+// The behavior of the client can be customized with options (WithHTTPClient, WithBaseURL, WithTimeout, WithUserAgent, WithMiddleware, etc)
+func NewClient(consumerKey string, opts ...Option) (*Client, error) {
 	if consumerKey == "" {
 		return nil, ErrEmptyConsumerKey
 	}
 
-	return &Client{
+	c := &Client{
 		client: &http.Client{
 			Timeout: defaultTimeout,
 		},
+		baseURL:     defaultBaseURL,
 		consumerKey: consumerKey,
-	}, nil
+	}
+
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.timeout > 0 {
+		httpClient := *c.client
+		httpClient.Timeout = c.timeout
+		c.client = &httpClient
+	}
+
+	return c, nil
 }
 
-// Add creates a new item in the Pocket list
-func (c *Client) Add(ctx context.Context, input AddInput) error {
+// Add creates a new item in the Pocket list and returns it as resolved by Pocket
+func (c *Client) Add(ctx context.Context, input AddInput) (Item, error) {
 	req, err := input.generateRequest(c.consumerKey)
 	if err != nil {
-		return err
+		return Item{}, err
 	}
 
-	_, err = c.doHTTP(ctx, endpointAdd, req)
-	return err
+	result, err := c.doHTTP(ctx, endpointAdd, req)
+	if err != nil {
+		return Item{}, err
+	}
+
+	return parseItem(result.Get("item")), nil
 }
 
 // Modify modifies Pocket user data (archives items, adds tags to an item, marks an item as a favorite, etc).
-func (c *Client) Modify(ctx context.Context, input ModifyInput) error {
+// The result contains the outcome of each action, so partial failures of a batch can be detected with ModifyResult.Err.
+// Large lists of actions are split into several requests according to the batch policy of the client (see WithBatchPolicy)
+func (c *Client) Modify(ctx context.Context, input ModifyInput) (ModifyResult, error) {
 	req, err := input.generateRequest(c.consumerKey)
 	if err != nil {
-		return err
+		return ModifyResult{}, err
+	}
+
+	if c.batchPolicy.Size > 0 && len(req.Actions) > c.batchPolicy.Size {
+		return c.modifyInBatches(ctx, req)
+	}
+
+	result, err := c.doHTTP(ctx, endpointModify, req)
+	if err != nil {
+		return ModifyResult{}, err
 	}
 
-	_, err = c.doHTTP(ctx, endpointModify, req)
-	return err
+	return parseModifyResult(input.Actions, result), nil
 }
 
 // Retrieving retrieves user data (items) Pocket, such as the item id, which is needed to modify items in the Modify function
 func (c *Client) Retrieving(ctx context.Context, input RetrievingInput) ([]Item, error) {
-	req, err := input.generateRequest(c.consumerKey)
+	result, err := c.Get(ctx, input)
 	if err != nil {
 		return nil, err
 	}
 
+	return result.Items, nil
+}
+
+// Get retrieves user data (items) Pocket like Retrieving, together with the metadata of the response (status, since, etc)
+func (c *Client) Get(ctx context.Context, input RetrievingInput) (RetrieveResult, error) {
+	req, err := input.generateRequest(c.consumerKey)
+	if err != nil {
+		return RetrieveResult{}, err
+	}
+
 	result, err := c.doHTTP(ctx, endpointRetrieving, req)
 	if err != nil {
-		return nil, err
+		return RetrieveResult{}, err
 	}
 
-	return c.parseItems(result), nil
+	return RetrieveResult{
+		Items:      c.parseItems(result, input.Sort),
+		Status:     int(result.Get("status").Int()),
+		Complete:   result.Get("complete").Int() == 1,
+		Since:      parseTime(result.Get("since")),
+		SearchType: result.Get("search_meta.search_type").String(),
+		Error:      result.Get("error").String(),
+		MaxActions: int(result.Get("maxActions").Int()),
+	}, nil
 }
 
-func (c *Client) parseItems(result gjson.Result) []Item {
+// parseItems returns the items in the order intended by Pocket: by sort_id if it is present,
+// otherwise by the requested sort order
+func (c *Client) parseItems(result gjson.Result, sortOrder Sort) []Item {
 	var items []Item
-	for itemID := range result.Get("list").Map() {
-		item := Item{ID: itemID}
-		item.fillAllFields(result)
+	hasSortID := true
+	result.Get("list").ForEach(func(itemID, value gjson.Result) bool {
+		item := Item{ID: itemID.String()}
+		item.fillAllFields(value)
 		items = append(items, item)
+		hasSortID = hasSortID && value.Get("sort_id").Exists()
+		return true
+	})
+
+	if hasSortID {
+		sort.SliceStable(items, func(i, j int) bool { return items[i].SortID < items[j].SortID })
+		return items
+	}
+
+	switch sortOrder {
+	case SortNewest:
+		sort.SliceStable(items, func(i, j int) bool { return items[i].TimeAdded.After(items[j].TimeAdded) })
+	case SortOldest:
+		sort.SliceStable(items, func(i, j int) bool { return items[i].TimeAdded.Before(items[j].TimeAdded) })
+	case SortTitle:
+		sort.SliceStable(items, func(i, j int) bool { return items[i].title() < items[j].title() })
+	case SortSite:
+		sort.SliceStable(items, func(i, j int) bool { return items[i].site() < items[j].site() })
 	}
 
 	return items
@@ -129,28 +206,26 @@ func (c *Client) Authorize(ctx context.Context, requestToken string) (Authorizat
 }
 
 // GetAuthorizationURL returns the url string that is used to grant the user access rights to his Pocket account in your application
-func (c Client) GetAuthorizationURL(requestToken string) (string, error) {
-	if requestToken == "" {
-		return "", ErrEmptyRequestToken
-	}
-
-	if c.redirectURL == "" {
-		return "", ErrEmptyRedirectURL
-	}
+func (c *Client) GetAuthorizationURL(requestToken RequestToken) (string, error) {
+	requestToken.baseURL = c.baseURL
+	return requestToken.AuthorizationURL()
+}
 
-	return fmt.Sprintf(authorizeURL, requestToken, c.redirectURL), nil
+// AuthorizationURL returns the url string that is used to grant the user access rights to his Pocket account in your application.
+// To use additional Pocket options, see NewAuthorizationURLBuilder
+func (t RequestToken) AuthorizationURL() (string, error) {
+	return NewAuthorizationURLBuilder(t).Build()
 }
 
-// GetRequestToken returns the request token (code), which will be used later to authenticate the user in your application.
+// GetRequestToken returns the request token, which will be used later to authenticate the user in your application.
 // RedirectURL - where the user will be redirected after authorization (better to specify a link to your application),
 // State - metadata string that will be returned at each subsequent authentication response (if you don't need it, specify an empty string).
-func (c *Client) GetRequestToken(ctx context.Context, redirectURL string, state string) (string, error) {
+// The returned token carries the state of the authorization flow, so a single client can serve many simultaneous logins
+func (c *Client) GetRequestToken(ctx context.Context, redirectURL string, state string) (RequestToken, error) {
 	if redirectURL == "" {
-		return "", ErrEmptyRedirectURL
+		return RequestToken{}, ErrEmptyRedirectURL
 	}
 
-	c.redirectURL = redirectURL
-
 	body := requestToken{
 		ConsumerKey: c.consumerKey,
 		RedirectURL: redirectURL,
@@ -159,50 +234,101 @@ func (c *Client) GetRequestToken(ctx context.Context, redirectURL string, state
 
 	result, err := c.doHTTP(ctx, endpointRequestToken, body)
 	if err != nil {
-		return "", err
+		return RequestToken{}, err
 	}
 
-	token := result.Get("code").String()
-	if token == "" {
-		return "", ErrEmptyRequestTokenInResponse
+	code := result.Get("code").String()
+	if code == "" {
+		return RequestToken{}, ErrEmptyRequestTokenInResponse
 	}
 
-	return token, nil
+	return RequestToken{
+		Code:        code,
+		RedirectURL: redirectURL,
+		State:       state,
+		baseURL:     c.baseURL,
+	}, nil
 }
 
 func (c *Client) doHTTP(ctx context.Context, endpoint string, body interface{}) (gjson.Result, error) {
 	b, err := json.Marshal(body)
 	if err != nil {
-		return gjson.Result{}, fmt.Errorf("error occurred when marshal the input body: %s", err.Error())
+		return gjson.Result{}, fmt.Errorf("error occurred when marshal the input body: %w", err)
+	}
+
+	accessToken := accessTokenOf(body)
+
+	maxAttempts := c.retryPolicy.MaxAttempts
+	if maxAttempts < 1 || !c.retryPolicy.RetryNonIdempotent && !isIdempotent(endpoint, body) {
+		maxAttempts = 1
 	}
 
-	req, err := http.NewRequestWithContext(ctx, "POST", host+endpoint, bytes.NewBufferString(string(b)))
+	handler := c.handler()
+	for attempt := 1; ; attempt++ {
+		result, err := c.attempt(ctx, handler, c.newRequest(endpoint, accessToken, b))
+		if err == nil || attempt >= maxAttempts || ctx.Err() != nil {
+			return result, err
+		}
+
+		delay, ok := c.retryPolicy.delay(attempt, err)
+		if !ok || !sleep(ctx, delay) {
+			return result, err
+		}
+	}
+}
+
+func (c *Client) attempt(ctx context.Context, handler Handler, req *Request) (gjson.Result, error) {
+	resp, err := handler(ctx, req)
 	if err != nil {
-		return gjson.Result{}, fmt.Errorf("error occurred when creating the query: %s", err.Error())
+		return gjson.Result{}, err
 	}
 
-	req.Header.Set("Content-Type", "application/json; charset=UTF-8")
-	req.Header.Set("X-Accept", "application/json")
+	if resp == nil {
+		return gjson.Result{}, ErrNoResponse
+	}
+
+	if err = resp.parse(); err != nil {
+		return gjson.Result{}, err
+	}
+
+	return resp.Result, nil
+}
+
+func (c *Client) send(ctx context.Context, r *Request) (*Response, error) {
+	if err := c.limits.wait(ctx, c.rateLimitPolicy, r.accessToken); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", c.baseURL+r.Endpoint, bytes.NewReader(r.Body))
+	if err != nil {
+		return nil, fmt.Errorf("error occurred when creating the query: %w", err)
+	}
+	req.Header = r.Header
 
 	resp, err := c.client.Do(req)
 	if err != nil {
-		return gjson.Result{}, fmt.Errorf("error occurred when sending a request to the Pocket server: %s", err.Error())
+		return nil, fmt.Errorf("error occurred when sending a request to the Pocket server: %w", err)
 	}
 	defer resp.Body.Close()
 
+	c.limits.update(r.accessToken, resp.Header)
+
 	if resp.StatusCode != http.StatusOK {
-		return gjson.Result{}, fmt.Errorf("API error %s: %s", resp.Header.Get(xErrorCodeHeader), resp.Header.Get(xErrorHeader))
+		code, _ := strconv.Atoi(resp.Header.Get(xErrorCodeHeader))
+		return &Response{HTTP: resp}, &APIError{
+			StatusCode: resp.StatusCode,
+			Code:       code,
+			Message:    resp.Header.Get(xErrorHeader),
+			Endpoint:   r.Endpoint,
+			Header:     resp.Header,
+		}
 	}
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return gjson.Result{}, fmt.Errorf("error occurred when reading the request body: %s", err.Error())
-	}
-
-	result := gjson.Parse(string(respBody))
-	if result.String() == "" {
-		return gjson.Result{}, ErrFailedToParseInputBody
+		return &Response{HTTP: resp}, fmt.Errorf("error occurred when reading the request body: %w", err)
 	}
 
-	return result, nil
+	response := &Response{HTTP: resp, Body: respBody}
+	return response, response.parse()
 }
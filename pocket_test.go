@@ -5,11 +5,14 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
 )
 
 type roundTripFunc func(r *http.Request) (*http.Response, error)
@@ -18,20 +21,157 @@ func (rt roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
 	return rt(r)
 }
 
+// newTestClient creates a client with the consumer key "consumer-key" through NewClient, sending its requests to rt
+func newTestClient(t *testing.T, rt roundTripFunc, opts ...Option) *Client {
+	t.Helper()
+
+	client, err := NewClient("consumer-key", append([]Option{WithHTTPClient(&http.Client{Transport: rt})}, opts...)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client
+}
+
+// stubResponse is a canned response of scriptedTransport, err is returned instead of the response if it is set
+type stubResponse struct {
+	statusCode int
+	header     http.Header
+	body       string
+	err        error
+}
+
+// scriptedTransport returns the responses in order (repeating the last one) and counts the requests in calls
+func scriptedTransport(calls *int, responses ...stubResponse) roundTripFunc {
+	return func(r *http.Request) (*http.Response, error) {
+		resp := responses[len(responses)-1]
+		if *calls < len(responses) {
+			resp = responses[*calls]
+		}
+		*calls++
+
+		if resp.err != nil {
+			return nil, resp.err
+		}
+
+		return &http.Response{
+			StatusCode: resp.statusCode,
+			Header:     resp.header,
+			Body:       io.NopCloser(strings.NewReader(resp.body)),
+		}, nil
+	}
+}
+
 func newClient(t *testing.T, statusCode int, path, responseBody string) *Client {
-	return &Client{
-		client: &http.Client{
-			Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
-				assert.Equal(t, path, r.URL.Path)
-				assert.Equal(t, http.MethodPost, r.Method)
-
-				return &http.Response{
-					StatusCode: statusCode,
-					Body:       io.NopCloser(strings.NewReader(responseBody)),
-				}, nil
-			}),
+	return newTestClient(t, func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, path, r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+
+		return &http.Response{
+			StatusCode: statusCode,
+			Body:       io.NopCloser(strings.NewReader(responseBody)),
+		}, nil
+	})
+}
+
+func TestNewClient(t *testing.T) {
+	httpClient := &http.Client{Timeout: time.Minute}
+
+	testCases := []struct {
+		name                 string
+		consumerKey          string
+		opts                 []Option
+		expectedBaseURL      string
+		expectedUserAgent    string
+		expectedTimeout      time.Duration
+		expectedErrorMessage string
+		wantErr              bool
+	}{
+		{
+			name:            "OK_Defaults",
+			consumerKey:     "consumer-key",
+			expectedBaseURL: defaultBaseURL,
+			expectedTimeout: defaultTimeout,
+			wantErr:         false,
+		},
+		{
+			name:        "OK_AllOptions",
+			consumerKey: "consumer-key",
+			opts: []Option{
+				WithHTTPClient(httpClient),
+				WithBaseURL("http://localhost:8080/"),
+				WithTimeout(time.Second),
+				WithUserAgent("pocket-test"),
+			},
+			expectedBaseURL:   "http://localhost:8080",
+			expectedUserAgent: "pocket-test",
+			expectedTimeout:   time.Second,
+			wantErr:           false,
+		},
+		{
+			name:        "OK_TimeoutBeforeHTTPClient",
+			consumerKey: "consumer-key",
+			opts: []Option{
+				WithTimeout(time.Second),
+				WithHTTPClient(httpClient),
+			},
+			expectedBaseURL: defaultBaseURL,
+			expectedTimeout: time.Second,
+			wantErr:         false,
+		},
+		{
+			name:            "OK_HTTPClientTimeout",
+			consumerKey:     "consumer-key",
+			opts:            []Option{WithHTTPClient(httpClient)},
+			expectedBaseURL: defaultBaseURL,
+			expectedTimeout: time.Minute,
+			wantErr:         false,
+		},
+		{
+			name:                 "Empty consumer key",
+			consumerKey:          "",
+			expectedErrorMessage: ErrEmptyConsumerKey.Error(),
+			wantErr:              true,
 		},
+		{
+			name:                 "Nil HTTP client",
+			consumerKey:          "consumer-key",
+			opts:                 []Option{WithHTTPClient(nil)},
+			expectedErrorMessage: ErrNilHTTPClient.Error(),
+			wantErr:              true,
+		},
+		{
+			name:                 "Invalid base URL",
+			consumerKey:          "consumer-key",
+			opts:                 []Option{WithBaseURL("localhost")},
+			expectedErrorMessage: ErrInvalidBaseURL.Error(),
+			wantErr:              true,
+		},
+		{
+			name:                 "Invalid timeout",
+			consumerKey:          "consumer-key",
+			opts:                 []Option{WithTimeout(0)},
+			expectedErrorMessage: ErrInvalidTimeout.Error(),
+			wantErr:              true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := NewClient(tc.consumerKey, tc.opts...)
+			if tc.wantErr {
+				assert.Error(t, err)
+				assert.Equal(t, tc.expectedErrorMessage, err.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedBaseURL, got.baseURL)
+				assert.Equal(t, tc.expectedUserAgent, got.userAgent)
+				assert.Equal(t, tc.expectedTimeout, got.client.Timeout)
+			}
+		})
 	}
+
+	assert.Equal(t, time.Minute, httpClient.Timeout)
 }
 
 func TestClient_Add(t *testing.T) {
@@ -45,6 +185,7 @@ func TestClient_Add(t *testing.T) {
 		input                args
 		expectedStatusCode   int
 		expectedResponseBody string
+		expectedItem         Item
 		expectedErrorMessage string
 		wantErr              bool
 	}{
@@ -64,6 +205,33 @@ func TestClient_Add(t *testing.T) {
 			expectedResponseBody: `{"status":1}`,
 			wantErr:              false,
 		},
+		{
+			name: "OK_ResolvedItem",
+			input: args{
+				ctx: context.Background(),
+				addInput: AddInput{
+					AccessToken: "access-token",
+					URL:         "https://github.com",
+				},
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: `{"item":{"item_id":"2653471235","normal_url":"http:\/\/github.com","resolved_id":"2653471235","resolved_url":"https:\/\/github.com\/","given_url":"https:\/\/github.com","title":"GitHub: Where the world builds software","excerpt":"GitHub is where over 65 million developers shape the future of software.","word_count":"1140","has_image":"1","has_video":"0","is_article":"0","lang":"en","images":{"1":{"item_id":"2653471235","image_id":"1","src":"https:\/\/github.githubassets.com\/images\/globe.jpg","width":"0","height":"0","credit":"","caption":""}}},"status":1}`,
+			expectedItem: Item{
+				ID:            "2653471235",
+				ResolvedID:    "2653471235",
+				GivenURL:      "https://github.com",
+				ResolvedURL:   "https://github.com/",
+				ResolvedTitle: "GitHub: Where the world builds software",
+				Excerpt:       "GitHub is where over 65 million developers shape the future of software.",
+				IsArticle:     "0",
+				HasImage:      "1",
+				HasVideo:      "0",
+				WordCount:     "1140",
+				Lang:          "en",
+				Images:        []Image{{ID: "1", Src: "https://github.githubassets.com/images/globe.jpg"}},
+			},
+			wantErr: false,
+		},
 		{
 			name: "OK_WithoutTweetID",
 			input: args{
@@ -147,7 +315,7 @@ func TestClient_Add(t *testing.T) {
 			},
 			expectedStatusCode:   400,
 			expectedResponseBody: `{"status":0}`,
-			expectedErrorMessage: "API error : ",
+			expectedErrorMessage: "API error 0 (HTTP 400): ",
 			wantErr:              true,
 		},
 	}
@@ -156,12 +324,13 @@ func TestClient_Add(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			client := newClient(t, tc.expectedStatusCode, "/v3/add", tc.expectedResponseBody)
 
-			err := client.Add(tc.input.ctx, tc.input.addInput)
+			got, err := client.Add(tc.input.ctx, tc.input.addInput)
 			if tc.wantErr {
 				assert.Error(t, err)
 				assert.Equal(t, tc.expectedErrorMessage, err.Error())
 			} else {
 				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedItem, got)
 			}
 		})
 	}
@@ -178,6 +347,7 @@ func TestClient_Modify(t *testing.T) {
 		input                args
 		expectedStatusCode   int
 		expectedResponseBody string
+		expectedSuccess      []bool
 		expectedErrorMessage string
 		wantErr              bool
 	}{
@@ -196,6 +366,25 @@ func TestClient_Modify(t *testing.T) {
 			},
 			expectedStatusCode:   200,
 			expectedResponseBody: `{"status":1}`,
+			expectedSuccess:      []bool{true, true, true},
+			wantErr:              false,
+		},
+		{
+			name: "OK_PartialFailure",
+			input: args{
+				ctx: context.Background(),
+				modifyInput: ModifyInput{
+					AccessToken: "access-token",
+					Actions: []Action{
+						{Name: ActionAdd, URL: "https://github.com"},
+						{Name: ActionArchive, ItemID: "654"},
+						{Name: ActionFavorite, ItemID: "321"},
+					},
+				},
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: `{"status":1,"action_results":[{"item_id":"987","resolved_url":"https:\/\/github.com"},false,true],"action_errors":[null,{"message":"Invalid item id","type":"Bad Request","code":422},null]}`,
+			expectedSuccess:      []bool{true, false, true},
 			wantErr:              false,
 		},
 		{
@@ -215,6 +404,21 @@ func TestClient_Modify(t *testing.T) {
 			expectedErrorMessage: ErrEmptyAccessToken.Error(),
 			wantErr:              true,
 		},
+		{
+			name: "Invalid action",
+			input: args{
+				ctx: context.Background(),
+				modifyInput: ModifyInput{
+					AccessToken: "access-token",
+					Actions: []Action{
+						NewArchiveAction("654"),
+						NewTagRenameAction("golang", ""),
+					},
+				},
+			},
+			expectedErrorMessage: "action 1: " + ErrEmptyActionTag.Error(),
+			wantErr:              true,
+		},
 		{
 			name: "Empty array actions",
 			input: args{
@@ -243,7 +447,7 @@ func TestClient_Modify(t *testing.T) {
 			},
 			expectedStatusCode:   400,
 			expectedResponseBody: `{"status":0}`,
-			expectedErrorMessage: "API error : ",
+			expectedErrorMessage: "API error 0 (HTTP 400): ",
 			wantErr:              true,
 		},
 	}
@@ -252,17 +456,38 @@ func TestClient_Modify(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			client := newClient(t, tc.expectedStatusCode, "/v3/send", tc.expectedResponseBody)
 
-			err := client.Modify(tc.input.ctx, tc.input.modifyInput)
+			got, err := client.Modify(tc.input.ctx, tc.input.modifyInput)
 			if tc.wantErr {
 				assert.Error(t, err)
 				assert.Equal(t, tc.expectedErrorMessage, err.Error())
 			} else {
 				assert.NoError(t, err)
+				assert.Len(t, got.Results, len(tc.input.modifyInput.Actions))
+				for i, result := range got.Results {
+					assert.Equal(t, tc.input.modifyInput.Actions[i], result.Action)
+					assert.Equal(t, tc.expectedSuccess[i], result.Success)
+				}
 			}
 		})
 	}
 }
 
+func TestModifyResult(t *testing.T) {
+	actions := []Action{
+		{Name: ActionAdd, URL: "https://github.com"},
+		{Name: ActionArchive, ItemID: "654"},
+	}
+	result := parseModifyResult(actions, gjson.Parse(`{"status":1,"action_results":[{"item_id":"987","resolved_id":"987","resolved_url":"https:\/\/github.com"},false],"action_errors":[null,{"message":"Invalid item id","type":"Bad Request","code":422}]}`))
+
+	assert.Equal(t, &Item{ID: "987", ResolvedID: "987", ResolvedURL: "https://github.com"}, result.Results[0].Item)
+	assert.Nil(t, result.Results[0].Error)
+	assert.Equal(t, &ActionError{Message: "Invalid item id", Type: "Bad Request", Code: 422}, result.Results[1].Error)
+	assert.Equal(t, []ActionResult{result.Results[1]}, result.Failed())
+	assert.EqualError(t, result.Err(), `1 of 2 actions failed, first "archive": Invalid item id`)
+
+	assert.NoError(t, parseModifyResult(actions, gjson.Parse(`{"status":1,"action_results":[true,true]}`)).Err())
+}
+
 func TestClient_Retrieving(t *testing.T) {
 	type args struct {
 		ctx             context.Context
@@ -308,6 +533,67 @@ func TestClient_Retrieving(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "OK_TypedFields",
+			input: args{
+				ctx: context.Background(),
+				retrievingInput: RetrievingInput{
+					AccessToken: "access-token",
+				},
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: `{"status":1,"list":{"229279689":{"item_id":"229279689","resolved_id":"229279689","favorite":"1","status":"1","time_added":"1473194502","time_updated":"1473194550","time_read":"1473194550","time_favorited":"1473194520","sort_id":0,"lang":"en","time_to_read":15,"listen_duration_estimate":1238,"top_image_url":"https:\/\/example.com\/image.jpg","amp_url":"","domain_metadata":{"name":"Grantland","logo":"https:\/\/example.com\/logo.png","greyscale_logo":"https:\/\/example.com\/greyscale_logo.png"}}}}`,
+			expectedItems: []Item{
+				{
+					ID:                     "229279689",
+					ResolvedID:             "229279689",
+					Favorite:               "1",
+					Status:                 "1",
+					TimeAdded:              time.Unix(1473194502, 0).UTC(),
+					TimeUpdated:            time.Unix(1473194550, 0).UTC(),
+					TimeRead:               time.Unix(1473194550, 0).UTC(),
+					TimeFavorited:          time.Unix(1473194520, 0).UTC(),
+					SortID:                 0,
+					Lang:                   "en",
+					TimeToRead:             15,
+					ListenDurationEstimate: 1238,
+					TopImageURL:            &url.URL{Scheme: "https", Host: "example.com", Path: "/image.jpg"},
+					DomainMetadata: DomainMetadata{
+						Name:          "Grantland",
+						Logo:          "https://example.com/logo.png",
+						GreyscaleLogo: "https://example.com/greyscale_logo.png",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "OK_CompleteDetails",
+			input: args{
+				ctx: context.Background(),
+				retrievingInput: RetrievingInput{
+					AccessToken: "access-token",
+					DetailType:  "complete",
+				},
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: `{"status":1,"list":{"229279689":{"item_id":"229279689","tags":{"golang":{"item_id":"229279689","tag":"golang"},"sdk":{"item_id":"229279689","tag":"sdk"}},"authors":{"3":{"item_id":"229279689","author_id":"3","name":"Rob Pike","url":"https:\/\/example.com\/rob"}},"image":{"item_id":"229279689","src":"https:\/\/example.com\/1.jpg","width":"640","height":"480"},"images":{"1":{"item_id":"229279689","image_id":"1","src":"https:\/\/example.com\/1.jpg","width":"640","height":"480","credit":"Gopher","caption":"Go"}},"videos":{"1":{"item_id":"229279689","video_id":"1","src":"http:\/\/www.youtube.com\/v\/Er34PbFkVGk","width":"420","height":"315","type":"1","vid":"Er34PbFkVGk","length":"0"}}}}}`,
+			expectedItems: []Item{
+				{
+					ID:       "229279689",
+					Tags:     []string{"golang", "sdk"},
+					Authors:  []Author{{ID: "3", Name: "Rob Pike", URL: "https://example.com/rob"}},
+					TopImage: &Image{Src: "https://example.com/1.jpg", Width: 640, Height: 480},
+					Images: []Image{
+						{ID: "1", Src: "https://example.com/1.jpg", Width: 640, Height: 480, Credit: "Gopher", Caption: "Go"},
+					},
+					Videos: []Video{
+						{ID: "1", Src: "http://www.youtube.com/v/Er34PbFkVGk", Width: 420, Height: 315, Type: 1, VID: "Er34PbFkVGk"},
+					},
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "Empty access token",
 			input: args{
@@ -330,7 +616,20 @@ func TestClient_Retrieving(t *testing.T) {
 				},
 			},
 			expectedStatusCode:   400,
-			expectedErrorMessage: "API error : ",
+			expectedErrorMessage: "API error 0 (HTTP 400): ",
+			wantErr:              true,
+		},
+		{
+			name: "Malformed response body",
+			input: args{
+				ctx: context.Background(),
+				retrievingInput: RetrievingInput{
+					AccessToken: "access-token",
+				},
+			},
+			expectedStatusCode:   200,
+			expectedResponseBody: `{"status":1,"list":{"229279689":{"item_id":"229279689"`,
+			expectedErrorMessage: ErrFailedToParseInputBody.Error(),
 			wantErr:              true,
 		},
 	}
@@ -351,6 +650,105 @@ func TestClient_Retrieving(t *testing.T) {
 	}
 }
 
+func TestClient_Get(t *testing.T) {
+	testCases := []struct {
+		name           string
+		responseBody   string
+		expectedResult RetrieveResult
+	}{
+		{
+			name:         "OK_AllFields",
+			responseBody: `{"status":1,"complete":1,"list":{"1":{"item_id":"1","sort_id":0}},"error":null,"search_meta":{"search_type":"normal"},"since":1632410435,"maxActions":30}`,
+			expectedResult: RetrieveResult{
+				Items:      []Item{{ID: "1"}},
+				Status:     1,
+				Complete:   true,
+				Since:      time.Unix(1632410435, 0).UTC(),
+				SearchType: "normal",
+				MaxActions: 30,
+			},
+		},
+		{
+			name:         "OK_PartialResult",
+			responseBody: `{"status":2,"complete":0,"list":[],"error":"partial result","since":1632410435}`,
+			expectedResult: RetrieveResult{
+				Status: 2,
+				Since:  time.Unix(1632410435, 0).UTC(),
+				Error:  "partial result",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := newClient(t, 200, "/v3/get", tc.responseBody)
+
+			got, err := client.Get(context.Background(), RetrievingInput{AccessToken: "access-token"})
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, got)
+		})
+	}
+}
+
+func TestClient_Retrieving_Order(t *testing.T) {
+	testCases := []struct {
+		name         string
+		sort         Sort
+		responseBody string
+		expectedIDs  []string
+	}{
+		{
+			name:         "By sort_id",
+			sort:         "oldest",
+			responseBody: `{"status":1,"list":{"3":{"item_id":"3","sort_id":2},"1":{"item_id":"1","sort_id":0},"2":{"item_id":"2","sort_id":1}}}`,
+			expectedIDs:  []string{"1", "2", "3"},
+		},
+		{
+			name:         "Newest",
+			sort:         "newest",
+			responseBody: `{"status":1,"list":{"1":{"item_id":"1","time_added":"100"},"3":{"item_id":"3","time_added":"300"},"2":{"item_id":"2","time_added":"200"}}}`,
+			expectedIDs:  []string{"3", "2", "1"},
+		},
+		{
+			name:         "Oldest",
+			sort:         "oldest",
+			responseBody: `{"status":1,"list":{"1":{"item_id":"1","time_added":"100"},"3":{"item_id":"3","time_added":"300"},"2":{"item_id":"2","time_added":"200"}}}`,
+			expectedIDs:  []string{"1", "2", "3"},
+		},
+		{
+			name:         "Title",
+			sort:         "title",
+			responseBody: `{"status":1,"list":{"1":{"item_id":"1","resolved_title":"Gamma"},"2":{"item_id":"2","given_title":"alpha"},"3":{"item_id":"3","resolved_title":"Beta"}}}`,
+			expectedIDs:  []string{"2", "3", "1"},
+		},
+		{
+			name:         "Site",
+			sort:         "site",
+			responseBody: `{"status":1,"list":{"1":{"item_id":"1","resolved_url":"https://www.golang.org/doc"},"2":{"item_id":"2","given_url":"https://github.com"},"3":{"item_id":"3","resolved_url":"https://example.com"}}}`,
+			expectedIDs:  []string{"3", "2", "1"},
+		},
+		{
+			name:         "Server order",
+			responseBody: `{"status":1,"list":{"2":{"item_id":"2"},"3":{"item_id":"3"},"1":{"item_id":"1"}}}`,
+			expectedIDs:  []string{"2", "3", "1"},
+		},
+		{
+			name:         "Empty list",
+			responseBody: `{"status":2,"list":[]}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := newClient(t, 200, "/v3/get", tc.responseBody)
+
+			got, err := client.Retrieving(context.Background(), RetrievingInput{AccessToken: "access-token", Sort: tc.sort})
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedIDs, itemIDs(got))
+		})
+	}
+}
+
 func TestClient_Authorize(t *testing.T) {
 	type args struct {
 		ctx          context.Context
@@ -404,7 +802,7 @@ func TestClient_Authorize(t *testing.T) {
 				requestToken: "request-token",
 			},
 			expectedStatusCode:   400,
-			expectedErrorMessage: "API error : ",
+			expectedErrorMessage: "API error 0 (HTTP 400): ",
 			wantErr:              true,
 		},
 	}
@@ -433,7 +831,7 @@ func TestClient_GetAuthorizationURL(t *testing.T) {
 	}
 
 	expectedAuthorizationURL := func(input args) string {
-		return fmt.Sprintf("https://getpocket.com/auth/authorize?request_token=%s&redirect_uri=%s", input.requestToken, input.redirectURL)
+		return fmt.Sprintf("https://getpocket.com/auth/authorize?request_token=%s&redirect_uri=%s", input.requestToken, url.QueryEscape(input.redirectURL))
 	}
 
 	testCases := []struct {
@@ -475,9 +873,10 @@ func TestClient_GetAuthorizationURL(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			client := &Client{redirectURL: tc.input.redirectURL}
+			client, err := NewClient("consumer-key")
+			assert.NoError(t, err)
 
-			got, err := client.GetAuthorizationURL(tc.input.requestToken)
+			got, err := client.GetAuthorizationURL(RequestToken{Code: tc.input.requestToken, RedirectURL: tc.input.redirectURL})
 			if tc.wantErr {
 				assert.Error(t, err)
 				assert.Equal(t, tc.expectedErrorMessage, err.Error())
@@ -501,7 +900,7 @@ func TestClient_GetRequestToken(t *testing.T) {
 		input                args
 		expectedStatusCode   int
 		expectedResponseBody string
-		expectedRequestToken string
+		expectedRequestToken RequestToken
 		expectedErrorMessage string
 		wantErr              bool
 	}{
@@ -514,7 +913,7 @@ func TestClient_GetRequestToken(t *testing.T) {
 			},
 			expectedStatusCode:   200,
 			expectedResponseBody: `{"code":"request-token","state":"testing"}`,
-			expectedRequestToken: "request-token",
+			expectedRequestToken: RequestToken{Code: "request-token", RedirectURL: "http://localhost", State: "testing", baseURL: defaultBaseURL},
 			wantErr:              false,
 		},
 		{
@@ -526,7 +925,7 @@ func TestClient_GetRequestToken(t *testing.T) {
 			},
 			expectedStatusCode:   200,
 			expectedResponseBody: `{"code":"request-token","state":null}`,
-			expectedRequestToken: "request-token",
+			expectedRequestToken: RequestToken{Code: "request-token", RedirectURL: "http://localhost", baseURL: defaultBaseURL},
 			wantErr:              false,
 		},
 		{
@@ -556,7 +955,7 @@ func TestClient_GetRequestToken(t *testing.T) {
 				redirectURL: "http://localhost",
 			},
 			expectedStatusCode:   400,
-			expectedErrorMessage: "API error : ",
+			expectedErrorMessage: "API error 0 (HTTP 400): ",
 			wantErr:              true,
 		},
 	}
@@ -576,3 +975,29 @@ func TestClient_GetRequestToken(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_GetRequestToken_Concurrent(t *testing.T) {
+	client := newTestClient(t, func(r *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"code":"request-token"}`)),
+		}, nil
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			redirectURL := fmt.Sprintf("http://localhost/user/%d", i)
+			token, err := client.GetRequestToken(context.Background(), redirectURL, "")
+			assert.NoError(t, err)
+
+			authURL, err := token.AuthorizationURL()
+			assert.NoError(t, err)
+			assert.Equal(t, "https://getpocket.com/auth/authorize?request_token=request-token&redirect_uri="+url.QueryEscape(redirectURL), authURL)
+		}(i)
+	}
+	wg.Wait()
+}
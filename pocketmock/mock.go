@@ -0,0 +1,249 @@
+// Package pocketmock provides a mock implementation of the pocket.API interface for unit tests.
+//
+// Every method records its call and delegates to the matching ...Func field, methods without a function
+// return zero values and a nil error:
+//
+//	api := &pocketmock.API{
+//		AddFunc: func(ctx context.Context, input pocket.AddInput) (pocket.Item, error) {
+//			return pocket.Item{ID: "1", GivenURL: input.URL}, nil
+//		},
+//	}
+//
+//	service := NewService(api)
+//	...
+//	assert.Len(t, api.AddCalls(), 1)
+package pocketmock
+
+import (
+	"context"
+	"sync"
+
+	pocket "github.com/Lapp-coder/go-pocket-sdk"
+)
+
+var _ pocket.API = (*API)(nil)
+
+// API is a mock implementation of pocket.API, safe for concurrent use
+type API struct {
+	AddFunc                 func(ctx context.Context, input pocket.AddInput) (pocket.Item, error)
+	ModifyFunc              func(ctx context.Context, input pocket.ModifyInput) (pocket.ModifyResult, error)
+	RetrievingFunc          func(ctx context.Context, input pocket.RetrievingInput) ([]pocket.Item, error)
+	GetFunc                 func(ctx context.Context, input pocket.RetrievingInput) (pocket.RetrieveResult, error)
+	AuthorizeFunc           func(ctx context.Context, requestToken string) (pocket.Authorization, error)
+	GetRequestTokenFunc     func(ctx context.Context, redirectURL string, state string) (pocket.RequestToken, error)
+	GetAuthorizationURLFunc func(requestToken pocket.RequestToken) (string, error)
+
+	mu    sync.Mutex
+	calls struct {
+		Add                 []AddCall
+		Modify              []ModifyCall
+		Retrieving          []RetrievingCall
+		Get                 []GetCall
+		Authorize           []AuthorizeCall
+		GetRequestToken     []GetRequestTokenCall
+		GetAuthorizationURL []GetAuthorizationURLCall
+	}
+}
+
+// AddCall holds the arguments of an API.Add call
+type AddCall struct {
+	Ctx   context.Context
+	Input pocket.AddInput
+}
+
+// ModifyCall holds the arguments of an API.Modify call
+type ModifyCall struct {
+	Ctx   context.Context
+	Input pocket.ModifyInput
+}
+
+// RetrievingCall holds the arguments of an API.Retrieving call
+type RetrievingCall struct {
+	Ctx   context.Context
+	Input pocket.RetrievingInput
+}
+
+// GetCall holds the arguments of an API.Get call
+type GetCall struct {
+	Ctx   context.Context
+	Input pocket.RetrievingInput
+}
+
+// AuthorizeCall holds the arguments of an API.Authorize call
+type AuthorizeCall struct {
+	Ctx          context.Context
+	RequestToken string
+}
+
+// GetRequestTokenCall holds the arguments of an API.GetRequestToken call
+type GetRequestTokenCall struct {
+	Ctx         context.Context
+	RedirectURL string
+	State       string
+}
+
+// GetAuthorizationURLCall holds the arguments of an API.GetAuthorizationURL call
+type GetAuthorizationURLCall struct {
+	RequestToken pocket.RequestToken
+}
+
+// Add calls AddFunc
+func (m *API) Add(ctx context.Context, input pocket.AddInput) (pocket.Item, error) {
+	m.mu.Lock()
+	m.calls.Add = append(m.calls.Add, AddCall{Ctx: ctx, Input: input})
+	m.mu.Unlock()
+
+	if m.AddFunc == nil {
+		return pocket.Item{}, nil
+	}
+
+	return m.AddFunc(ctx, input)
+}
+
+// AddCalls returns the recorded calls of Add
+func (m *API) AddCalls() []AddCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]AddCall(nil), m.calls.Add...)
+}
+
+// Modify calls ModifyFunc
+func (m *API) Modify(ctx context.Context, input pocket.ModifyInput) (pocket.ModifyResult, error) {
+	m.mu.Lock()
+	m.calls.Modify = append(m.calls.Modify, ModifyCall{Ctx: ctx, Input: input})
+	m.mu.Unlock()
+
+	if m.ModifyFunc == nil {
+		return pocket.ModifyResult{}, nil
+	}
+
+	return m.ModifyFunc(ctx, input)
+}
+
+// ModifyCalls returns the recorded calls of Modify
+func (m *API) ModifyCalls() []ModifyCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]ModifyCall(nil), m.calls.Modify...)
+}
+
+// Retrieving calls RetrievingFunc
+func (m *API) Retrieving(ctx context.Context, input pocket.RetrievingInput) ([]pocket.Item, error) {
+	m.mu.Lock()
+	m.calls.Retrieving = append(m.calls.Retrieving, RetrievingCall{Ctx: ctx, Input: input})
+	m.mu.Unlock()
+
+	if m.RetrievingFunc == nil {
+		return nil, nil
+	}
+
+	return m.RetrievingFunc(ctx, input)
+}
+
+// RetrievingCalls returns the recorded calls of Retrieving
+func (m *API) RetrievingCalls() []RetrievingCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]RetrievingCall(nil), m.calls.Retrieving...)
+}
+
+// Get calls GetFunc
+func (m *API) Get(ctx context.Context, input pocket.RetrievingInput) (pocket.RetrieveResult, error) {
+	m.mu.Lock()
+	m.calls.Get = append(m.calls.Get, GetCall{Ctx: ctx, Input: input})
+	m.mu.Unlock()
+
+	if m.GetFunc == nil {
+		return pocket.RetrieveResult{}, nil
+	}
+
+	return m.GetFunc(ctx, input)
+}
+
+// GetCalls returns the recorded calls of Get
+func (m *API) GetCalls() []GetCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]GetCall(nil), m.calls.Get...)
+}
+
+// Authorize calls AuthorizeFunc
+func (m *API) Authorize(ctx context.Context, requestToken string) (pocket.Authorization, error) {
+	m.mu.Lock()
+	m.calls.Authorize = append(m.calls.Authorize, AuthorizeCall{Ctx: ctx, RequestToken: requestToken})
+	m.mu.Unlock()
+
+	if m.AuthorizeFunc == nil {
+		return pocket.Authorization{}, nil
+	}
+
+	return m.AuthorizeFunc(ctx, requestToken)
+}
+
+// AuthorizeCalls returns the recorded calls of Authorize
+func (m *API) AuthorizeCalls() []AuthorizeCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]AuthorizeCall(nil), m.calls.Authorize...)
+}
+
+// GetRequestToken calls GetRequestTokenFunc
+func (m *API) GetRequestToken(ctx context.Context, redirectURL string, state string) (pocket.RequestToken, error) {
+	m.mu.Lock()
+	m.calls.GetRequestToken = append(m.calls.GetRequestToken, GetRequestTokenCall{Ctx: ctx, RedirectURL: redirectURL, State: state})
+	m.mu.Unlock()
+
+	if m.GetRequestTokenFunc == nil {
+		return pocket.RequestToken{}, nil
+	}
+
+	return m.GetRequestTokenFunc(ctx, redirectURL, state)
+}
+
+// GetRequestTokenCalls returns the recorded calls of GetRequestToken
+func (m *API) GetRequestTokenCalls() []GetRequestTokenCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]GetRequestTokenCall(nil), m.calls.GetRequestToken...)
+}
+
+// GetAuthorizationURL calls GetAuthorizationURLFunc
+func (m *API) GetAuthorizationURL(requestToken pocket.RequestToken) (string, error) {
+	m.mu.Lock()
+	m.calls.GetAuthorizationURL = append(m.calls.GetAuthorizationURL, GetAuthorizationURLCall{RequestToken: requestToken})
+	m.mu.Unlock()
+
+	if m.GetAuthorizationURLFunc == nil {
+		return "", nil
+	}
+
+	return m.GetAuthorizationURLFunc(requestToken)
+}
+
+// GetAuthorizationURLCalls returns the recorded calls of GetAuthorizationURL
+func (m *API) GetAuthorizationURLCalls() []GetAuthorizationURLCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]GetAuthorizationURLCall(nil), m.calls.GetAuthorizationURL...)
+}
+
+// Reset forgets the recorded calls, the programmed functions are kept
+func (m *API) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.calls.Add = nil
+	m.calls.Modify = nil
+	m.calls.Retrieving = nil
+	m.calls.Get = nil
+	m.calls.Authorize = nil
+	m.calls.GetRequestToken = nil
+	m.calls.GetAuthorizationURL = nil
+}
@@ -0,0 +1,51 @@
+package pocketmock_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	pocket "github.com/Lapp-coder/go-pocket-sdk"
+	"github.com/Lapp-coder/go-pocket-sdk/pocketmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPI(t *testing.T) {
+	ctx := context.Background()
+	errAdd := errors.New("add failed")
+
+	api := &pocketmock.API{
+		AddFunc: func(ctx context.Context, input pocket.AddInput) (pocket.Item, error) {
+			if input.URL == "" {
+				return pocket.Item{}, errAdd
+			}
+
+			return pocket.Item{ID: "1", GivenURL: input.URL}, nil
+		},
+	}
+
+	var client pocket.API = api
+
+	item, err := client.Add(ctx, pocket.AddInput{URL: "https://github.com", AccessToken: "access-token"})
+	assert.NoError(t, err)
+	assert.Equal(t, pocket.Item{ID: "1", GivenURL: "https://github.com"}, item)
+
+	_, err = client.Add(ctx, pocket.AddInput{AccessToken: "access-token"})
+	assert.ErrorIs(t, err, errAdd)
+
+	items, err := client.Retrieving(ctx, pocket.RetrievingInput{AccessToken: "access-token"})
+	assert.NoError(t, err)
+	assert.Nil(t, items)
+
+	calls := api.AddCalls()
+	if assert.Len(t, calls, 2) {
+		assert.Equal(t, "https://github.com", calls[0].Input.URL)
+		assert.Equal(t, "", calls[1].Input.URL)
+	}
+	assert.Len(t, api.RetrievingCalls(), 1)
+	assert.Empty(t, api.ModifyCalls())
+
+	api.Reset()
+	assert.Empty(t, api.AddCalls())
+	assert.Empty(t, api.RetrievingCalls())
+}
@@ -0,0 +1,133 @@
+package pockettest
+
+import (
+	"errors"
+	"net/http"
+	"time"
+)
+
+type action struct {
+	Name   string `json:"action"`
+	ItemID string `json:"item_id"`
+	RefID  string `json:"ref_id"`
+	Tags   string `json:"tags"`
+	Tag    string `json:"tag"`
+	Time   int64  `json:"time"`
+	Title  string `json:"title"`
+	URL    string `json:"url"`
+	OldTag string `json:"old_tag"`
+	NewTag string `json:"new_tag"`
+}
+
+var (
+	errInvalidItemID = errors.New("Invalid item id")
+	errMissingURL    = errors.New("Missing url")
+	errMissingTags   = errors.New("Missing tags")
+	errUnknownAction = errors.New("Invalid action")
+)
+
+func (s *Server) handleModify(w http.ResponseWriter, u *user, req request) {
+	if len(req.Actions) == 0 {
+		writeError(w, http.StatusBadRequest, 0, "Missing actions.")
+		return
+	}
+
+	status := 1
+	results := make([]interface{}, len(req.Actions))
+	errs := make([]interface{}, len(req.Actions))
+	for i, a := range req.Actions {
+		result, err := s.apply(u, a)
+		if err != nil {
+			status = 0
+			results[i] = false
+			errs[i] = map[string]interface{}{"message": err.Error(), "type": "Bad Request", "code": 422}
+			continue
+		}
+
+		results[i] = result
+	}
+
+	writeJSON(w, map[string]interface{}{"status": status, "action_results": results, "action_errors": errs})
+}
+
+// apply performs the action and returns its result: the added item for "add" and true for other actions
+func (s *Server) apply(u *user, a action) (interface{}, error) {
+	at := s.now()
+	if a.Time > 0 {
+		at = time.Unix(a.Time, 0)
+	}
+
+	switch a.Name {
+	case "add":
+		if a.URL == "" {
+			if item := u.item(a.ItemID); item != nil {
+				a.URL = item.URL
+			} else {
+				return nil, errMissingURL
+			}
+		}
+
+		return s.add(u, a.URL, a.Title, splitTags(a.Tags), at).toJSON(false), nil
+	case "tag_rename":
+		for _, item := range u.items {
+			if item.Status != StatusDeleted && containsTag(item.Tags, a.OldTag) {
+				item.Tags = mergeTags(removeTags(item.Tags, []string{a.OldTag}), []string{a.NewTag})
+				item.TimeUpdated = at
+			}
+		}
+
+		return true, nil
+	case "tag_delete":
+		for _, item := range u.items {
+			if item.Status != StatusDeleted && containsTag(item.Tags, a.Tag) {
+				item.Tags = removeTags(item.Tags, []string{a.Tag})
+				item.TimeUpdated = at
+			}
+		}
+
+		return true, nil
+	}
+
+	item := u.item(a.ItemID)
+	if item == nil {
+		return nil, errInvalidItemID
+	}
+
+	switch a.Name {
+	case "archive":
+		item.Status = StatusArchived
+		item.TimeRead = at
+	case "readd":
+		item.Status = StatusUnread
+		item.TimeRead = time.Time{}
+	case "favorite":
+		item.Favorite = true
+		item.TimeFavorited = at
+	case "unfavorite":
+		item.Favorite = false
+		item.TimeFavorited = time.Time{}
+	case "delete":
+		item.Status = StatusDeleted
+	case "tags_add", "tags_remove", "tags_replace":
+		tags := splitTags(a.Tags)
+		if len(tags) == 0 {
+			return nil, errMissingTags
+		}
+
+		switch a.Name {
+		case "tags_add":
+			item.Tags = mergeTags(item.Tags, tags)
+		case "tags_remove":
+			item.Tags = removeTags(item.Tags, tags)
+		default:
+			item.Tags = tags
+		}
+	case "tags_clear":
+		item.Tags = nil
+	default:
+		return nil, errUnknownAction
+	}
+
+	item.TimeUpdated = at
+	return true, nil
+}
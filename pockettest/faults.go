@@ -0,0 +1,194 @@
+package pockettest
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	pocket "github.com/Lapp-coder/go-pocket-sdk"
+)
+
+const (
+	xLimitUserLimitHeader     = "X-Limit-User-Limit"
+	xLimitUserRemainingHeader = "X-Limit-User-Remaining"
+	xLimitUserResetHeader     = "X-Limit-User-Reset"
+	xLimitKeyLimitHeader      = "X-Limit-Key-Limit"
+	xLimitKeyRemainingHeader  = "X-Limit-Key-Remaining"
+	xLimitKeyResetHeader      = "X-Limit-Key-Reset"
+)
+
+// Fault describes a failure injected into responses of the fake server.
+// A fault with only Delay set slows the response down without changing it
+type Fault struct {
+	// Endpoint limits the fault to requests to the endpoint (for example, "/v3/get"), empty means all endpoints
+	Endpoint string
+	// Nth injects the fault only into the Nth matching request (starting from 1), 0 means every matching request
+	Nth int
+	// Times limits the number of injections, 0 means unlimited
+	Times int
+	// Probability injects the fault into a matching request with the probability, 0 means always
+	Probability float64
+
+	// Delay is the time to wait before responding (the wait stops if the client cancels the request)
+	Delay time.Duration
+	// StatusCode, ErrorCode and ErrorMessage define the error response (X-Error-Code and X-Error headers)
+	StatusCode   int
+	ErrorCode    int
+	ErrorMessage string
+	// Header contains additional response headers, such as rate limit headers
+	Header http.Header
+	// Body is the raw response body, for example malformed JSON (the status code is 200 if StatusCode is not set)
+	Body string
+}
+
+// InjectedFault is a fault injected into the server
+type InjectedFault struct {
+	Fault
+
+	server   *Server
+	seen     int
+	injected int
+}
+
+// ErrorFault returns a fault responding to requests to the endpoint with the Pocket error
+func ErrorFault(endpoint string, statusCode, errorCode int, message string) Fault {
+	return Fault{Endpoint: endpoint, StatusCode: statusCode, ErrorCode: errorCode, ErrorMessage: message}
+}
+
+// InvalidAccessTokenFault returns a fault responding with 401 and X-Error-Code 107
+func InvalidAccessTokenFault(endpoint string) Fault {
+	return ErrorFault(endpoint, http.StatusUnauthorized, pocket.ErrorCodeInvalidAccessToken, "Invalid access token.")
+}
+
+// InvalidConsumerKeyFault returns a fault responding with 403 and X-Error-Code 152
+func InvalidConsumerKeyFault(endpoint string) Fault {
+	return ErrorFault(endpoint, http.StatusForbidden, pocket.ErrorCodeInvalidConsumerKey, "Invalid consumer key.")
+}
+
+// ServerIssueFault returns a fault responding with 500 and X-Error-Code 199
+func ServerIssueFault(endpoint string) Fault {
+	return ErrorFault(endpoint, http.StatusInternalServerError, pocket.ErrorCodeServerIssue, "Pocket server issue.")
+}
+
+// ServiceUnavailableFault returns a fault responding with 503, as Pocket does during maintenance
+func ServiceUnavailableFault(endpoint string) Fault {
+	return Fault{Endpoint: endpoint, StatusCode: http.StatusServiceUnavailable}
+}
+
+// RateLimitFault returns a fault responding with 403 and the exhausted user rate limit, which is reset after the duration
+func RateLimitFault(endpoint string, reset time.Duration) Fault {
+	return Fault{
+		Endpoint:     endpoint,
+		StatusCode:   http.StatusForbidden,
+		ErrorMessage: "User rate limit exceeded.",
+		Header: http.Header{
+			xLimitUserLimitHeader:     {"320"},
+			xLimitUserRemainingHeader: {"0"},
+			xLimitUserResetHeader:     {strconv.Itoa(int(reset / time.Second))},
+			xLimitKeyLimitHeader:      {"10000"},
+			xLimitKeyRemainingHeader:  {"9000"},
+			xLimitKeyResetHeader:      {"3600"},
+		},
+	}
+}
+
+// SlowFault returns a fault delaying responses to requests to the endpoint
+func SlowFault(endpoint string, delay time.Duration) Fault {
+	return Fault{Endpoint: endpoint, Delay: delay}
+}
+
+// MalformedJSONFault returns a fault responding with 200 and a body that is not valid JSON
+func MalformedJSONFault(endpoint string) Fault {
+	return Fault{Endpoint: endpoint, Body: `{"status":1,"list":{`}
+}
+
+// InjectFault adds the fault to the server. Faults are checked in the order of injection and only the first matching one is applied
+func (s *Server) InjectFault(fault Fault) *InjectedFault {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	injected := &InjectedFault{Fault: fault, server: s}
+	s.faults = append(s.faults, injected)
+
+	return injected
+}
+
+// ClearFaults removes all injected faults
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = nil
+}
+
+// Requests returns the number of requests received by the endpoint, including the failed ones
+func (s *Server) Requests(endpoint string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.requests[endpoint]
+}
+
+// Injected returns the number of responses the fault was applied to
+func (f *InjectedFault) Injected() int {
+	f.server.mu.Lock()
+	defer f.server.mu.Unlock()
+
+	return f.injected
+}
+
+// fault counts the request and returns the fault to apply to it, if any. It must be called with s.mu held
+func (s *Server) fault(endpoint string) *Fault {
+	s.requests[endpoint]++
+
+	for _, f := range s.faults {
+		if f.Endpoint != "" && f.Endpoint != endpoint {
+			continue
+		}
+
+		f.seen++
+		if f.Nth > 0 && f.seen != f.Nth || f.Times > 0 && f.injected >= f.Times {
+			continue
+		}
+
+		if f.Probability > 0 && s.rand.Float64() >= f.Probability {
+			continue
+		}
+
+		f.injected++
+		fault := f.Fault
+		return &fault
+	}
+
+	return nil
+}
+
+// apply writes the response of the fault and returns true, or returns false if the request should be handled normally
+func (f *Fault) apply(w http.ResponseWriter, r *http.Request) bool {
+	if f.Delay > 0 {
+		timer := time.NewTimer(f.Delay)
+		select {
+		case <-r.Context().Done():
+			timer.Stop()
+			return true
+		case <-timer.C:
+		}
+	}
+
+	if f.StatusCode == 0 && f.Body == "" {
+		return false
+	}
+
+	for key, values := range f.Header {
+		w.Header()[key] = values
+	}
+
+	if f.StatusCode != 0 && f.StatusCode != http.StatusOK {
+		writeError(w, f.StatusCode, f.ErrorCode, f.ErrorMessage)
+		return true
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write([]byte(f.Body))
+	return true
+}
@@ -0,0 +1,141 @@
+package pockettest_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	pocket "github.com/Lapp-coder/go-pocket-sdk"
+	"github.com/Lapp-coder/go-pocket-sdk/pockettest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServer_InjectFault(t *testing.T) {
+	retryPolicy := pocket.RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond}
+
+	testCases := []struct {
+		name             string
+		faults           []pockettest.Fault
+		opts             []pocket.Option
+		check            func(t *testing.T, err error)
+		expectedRequests int
+	}{
+		{
+			name:             "OK_RetriedServiceUnavailable",
+			faults:           []pockettest.Fault{{Endpoint: "/v3/get", Times: 2, StatusCode: 503}},
+			opts:             []pocket.Option{pocket.WithRetryPolicy(retryPolicy)},
+			check:            func(t *testing.T, err error) { assert.NoError(t, err) },
+			expectedRequests: 3,
+		},
+		{
+			name:             "OK_NthRequest",
+			faults:           []pockettest.Fault{{Endpoint: "/v3/get", Nth: 2, StatusCode: 500, ErrorCode: pocket.ErrorCodeServerIssue}},
+			check:            func(t *testing.T, err error) { assert.NoError(t, err) },
+			expectedRequests: 1,
+		},
+		{
+			name:             "Invalid access token",
+			faults:           []pockettest.Fault{pockettest.InvalidAccessTokenFault("/v3/get")},
+			opts:             []pocket.Option{pocket.WithRetryPolicy(retryPolicy)},
+			check:            func(t *testing.T, err error) { assert.True(t, pocket.IsAuthError(err)) },
+			expectedRequests: 1,
+		},
+		{
+			name:   "Invalid consumer key",
+			faults: []pockettest.Fault{pockettest.InvalidConsumerKeyFault("")},
+			check: func(t *testing.T, err error) {
+				var apiErr *pocket.APIError
+				assert.ErrorAs(t, err, &apiErr)
+				assert.Equal(t, pocket.ErrorCodeInvalidConsumerKey, apiErr.Code)
+			},
+			expectedRequests: 1,
+		},
+		{
+			name:             "Server issue",
+			faults:           []pockettest.Fault{pockettest.ServerIssueFault("/v3/get")},
+			opts:             []pocket.Option{pocket.WithRetryPolicy(retryPolicy)},
+			check:            func(t *testing.T, err error) { assert.True(t, pocket.IsServerError(err)) },
+			expectedRequests: 3,
+		},
+		{
+			name:   "Rate limited",
+			faults: []pockettest.Fault{pockettest.RateLimitFault("/v3/get", time.Hour)},
+			opts:   []pocket.Option{pocket.WithRetryPolicy(retryPolicy)},
+			check: func(t *testing.T, err error) {
+				assert.True(t, pocket.IsRateLimited(err))
+			},
+			expectedRequests: 1,
+		},
+		{
+			name:   "Slow response",
+			faults: []pockettest.Fault{pockettest.SlowFault("/v3/get", time.Second)},
+			opts:   []pocket.Option{pocket.WithTimeout(20 * time.Millisecond)},
+			check: func(t *testing.T, err error) {
+				var netErr net.Error
+				assert.True(t, errors.As(err, &netErr) && netErr.Timeout())
+			},
+			expectedRequests: 1,
+		},
+		{
+			name:             "Malformed JSON",
+			faults:           []pockettest.Fault{pockettest.MalformedJSONFault("/v3/get")},
+			check:            func(t *testing.T, err error) { assert.ErrorIs(t, err, pocket.ErrFailedToParseInputBody) },
+			expectedRequests: 1,
+		},
+		{
+			name:             "Fault of other endpoint",
+			faults:           []pockettest.Fault{pockettest.ServerIssueFault("/v3/add")},
+			check:            func(t *testing.T, err error) { assert.NoError(t, err) },
+			expectedRequests: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := pockettest.NewServer("consumer-key")
+			defer srv.Close()
+
+			accessToken := srv.AddUser("pocket-user")
+			for _, fault := range tc.faults {
+				srv.InjectFault(fault)
+			}
+
+			client, err := pocket.NewClient("consumer-key", append([]pocket.Option{pocket.WithBaseURL(srv.URL)}, tc.opts...)...)
+			assert.NoError(t, err)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			_, err = client.Retrieving(ctx, pocket.RetrievingInput{AccessToken: accessToken})
+			tc.check(t, err)
+			assert.Equal(t, tc.expectedRequests, srv.Requests("/v3/get"))
+		})
+	}
+}
+
+func TestServer_InjectFault_Probability(t *testing.T) {
+	srv := pockettest.NewServer("consumer-key", pockettest.WithRandSeed(1))
+	defer srv.Close()
+
+	accessToken := srv.AddUser("pocket-user")
+	fault := srv.InjectFault(pockettest.Fault{Endpoint: "/v3/get", Probability: 0.5, StatusCode: 503})
+
+	client, err := pocket.NewClient("consumer-key", pocket.WithBaseURL(srv.URL))
+	assert.NoError(t, err)
+
+	var failed int
+	for i := 0; i < 100; i++ {
+		if _, err = client.Retrieving(context.Background(), pocket.RetrievingInput{AccessToken: accessToken}); err != nil {
+			failed++
+		}
+	}
+
+	assert.Equal(t, failed, fault.Injected())
+	assert.InDelta(t, 50, failed, 20)
+
+	srv.ClearFaults()
+	_, err = client.Retrieving(context.Background(), pocket.RetrievingInput{AccessToken: accessToken})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,394 @@
+package pockettest
+
+import (
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Statuses of items
+const (
+	StatusUnread   = "0"
+	StatusArchived = "1"
+	StatusDeleted  = "2"
+)
+
+const maxActions = 30
+
+// Item is an item in the Pocket list of a user
+type Item struct {
+	ID            string
+	URL           string
+	Title         string
+	Excerpt       string
+	Status        string
+	Favorite      bool
+	Tags          []string
+	IsArticle     bool
+	HasImage      bool
+	HasVideo      bool
+	WordCount     int
+	Lang          string
+	TimeAdded     time.Time
+	TimeUpdated   time.Time
+	TimeRead      time.Time
+	TimeFavorited time.Time
+}
+
+// AddItem adds the item to the list of the user with the access token and returns its ID.
+// Empty ID, Status and times are filled automatically
+func (s *Server) AddItem(accessToken string, item Item) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, ok := s.users[accessToken]
+	if !ok {
+		panic("pockettest: unknown access token " + accessToken)
+	}
+
+	now := s.now()
+	if item.ID == "" {
+		item.ID = s.nextID()
+	}
+	if item.Status == "" {
+		item.Status = StatusUnread
+	}
+	if item.TimeAdded.IsZero() {
+		item.TimeAdded = now
+	}
+	if item.TimeUpdated.IsZero() {
+		item.TimeUpdated = item.TimeAdded
+	}
+
+	item.Tags = append([]string(nil), item.Tags...)
+	u.items = append(u.items, &item)
+
+	return item.ID
+}
+
+// Items returns a copy of the items of the user with the access token, including deleted ones
+func (s *Server) Items(accessToken string) []Item {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, ok := s.users[accessToken]
+	if !ok {
+		return nil
+	}
+
+	items := make([]Item, 0, len(u.items))
+	for _, item := range u.items {
+		copied := *item
+		copied.Tags = append([]string(nil), item.Tags...)
+		items = append(items, copied)
+	}
+
+	return items
+}
+
+func (s *Server) nextID() string {
+	s.lastID++
+	return strconv.Itoa(s.lastID)
+}
+
+func (u *user) item(itemID string) *Item {
+	for _, item := range u.items {
+		if item.ID == itemID && item.Status != StatusDeleted {
+			return item
+		}
+	}
+
+	return nil
+}
+
+func (u *user) itemByURL(rawURL string) *Item {
+	for _, item := range u.items {
+		if item.URL == rawURL && item.Status != StatusDeleted {
+			return item
+		}
+	}
+
+	return nil
+}
+
+// add adds a new item or re-adds the existing item with the same URL
+func (s *Server) add(u *user, rawURL, title string, tags []string, at time.Time) *Item {
+	item := u.itemByURL(rawURL)
+	if item == nil {
+		item = &Item{
+			ID:        s.nextID(),
+			URL:       rawURL,
+			IsArticle: true,
+			TimeAdded: at,
+		}
+		u.items = append(u.items, item)
+	}
+
+	if title != "" {
+		item.Title = title
+	}
+
+	item.Status = StatusUnread
+	item.Tags = mergeTags(item.Tags, tags)
+	item.TimeUpdated = at
+
+	return item
+}
+
+func (s *Server) handleAdd(w http.ResponseWriter, u *user, req request) {
+	if req.URL == "" {
+		writeError(w, http.StatusBadRequest, 0, "Missing url.")
+		return
+	}
+
+	item := s.add(u, req.URL, req.Title, splitTags(req.Tags), s.now())
+
+	writeJSON(w, map[string]interface{}{"item": item.toJSON(false), "status": 1})
+}
+
+func (s *Server) handleRetrieving(w http.ResponseWriter, u *user, req request) {
+	var items []*Item
+	for _, item := range u.items {
+		if req.matches(item) {
+			items = append(items, item)
+		}
+	}
+
+	sortItems(items, req.Sort)
+
+	if req.Offset > 0 {
+		if req.Offset > len(items) {
+			req.Offset = len(items)
+		}
+		items = items[req.Offset:]
+	}
+
+	// the response is complete unless count leaves out some of the matching items
+	complete := 1
+	if req.Count > 0 && req.Count < len(items) {
+		items = items[:req.Count]
+		complete = 0
+	}
+
+	status := 1
+	var list interface{} = []interface{}{}
+	if len(items) == 0 {
+		status = 2
+	} else {
+		m := make(map[string]interface{}, len(items))
+		for n, item := range items {
+			value := item.toJSON(req.DetailType == "complete")
+			value["sort_id"] = n
+			m[item.ID] = value
+		}
+		list = m
+	}
+
+	writeJSON(w, map[string]interface{}{
+		"status":      status,
+		"complete":    complete,
+		"list":        list,
+		"error":       nil,
+		"search_meta": map[string]interface{}{"search_type": "normal"},
+		"since":       s.now().Unix(),
+		"maxActions":  maxActions,
+	})
+}
+
+func (req request) matches(item *Item) bool {
+	if req.Since > 0 {
+		if item.TimeUpdated.Unix() < req.Since {
+			return false
+		}
+
+		// deleted items are returned only to report the deletion since the previous request
+		if item.Status == StatusDeleted {
+			return true
+		}
+	} else if item.Status == StatusDeleted {
+		return false
+	}
+
+	switch req.State {
+	case "", "unread":
+		if item.Status != StatusUnread {
+			return false
+		}
+	case "archive":
+		if item.Status != StatusArchived {
+			return false
+		}
+	}
+
+	switch req.Favorite {
+	case "0":
+		if item.Favorite {
+			return false
+		}
+	case "1":
+		if !item.Favorite {
+			return false
+		}
+	}
+
+	switch req.Tag {
+	case "":
+	case "_untagged_":
+		if len(item.Tags) > 0 {
+			return false
+		}
+	default:
+		if !containsTag(item.Tags, req.Tag) {
+			return false
+		}
+	}
+
+	switch req.ContentType {
+	case "article":
+		if !item.IsArticle {
+			return false
+		}
+	case "video":
+		if !item.HasVideo {
+			return false
+		}
+	case "image":
+		if !item.HasImage {
+			return false
+		}
+	}
+
+	if req.Search != "" {
+		search := strings.ToLower(req.Search)
+		if !strings.Contains(strings.ToLower(item.Title), search) && !strings.Contains(strings.ToLower(item.URL), search) {
+			return false
+		}
+	}
+
+	if req.Domain != "" && !strings.Contains(host(item.URL), strings.ToLower(req.Domain)) {
+		return false
+	}
+
+	return true
+}
+
+func sortItems(items []*Item, order string) {
+	switch order {
+	case "oldest":
+		sort.SliceStable(items, func(i, j int) bool { return items[i].TimeAdded.Before(items[j].TimeAdded) })
+	case "title":
+		sort.SliceStable(items, func(i, j int) bool { return strings.ToLower(items[i].Title) < strings.ToLower(items[j].Title) })
+	case "site":
+		sort.SliceStable(items, func(i, j int) bool { return host(items[i].URL) < host(items[j].URL) })
+	default:
+		sort.SliceStable(items, func(i, j int) bool { return items[i].TimeAdded.After(items[j].TimeAdded) })
+	}
+}
+
+// toJSON returns the item in the format of the Pocket API
+func (item *Item) toJSON(complete bool) map[string]interface{} {
+	if item.Status == StatusDeleted {
+		return map[string]interface{}{"item_id": item.ID, "status": item.Status}
+	}
+
+	value := map[string]interface{}{
+		"item_id":        item.ID,
+		"resolved_id":    item.ID,
+		"given_url":      item.URL,
+		"given_title":    item.Title,
+		"resolved_url":   item.URL,
+		"resolved_title": item.Title,
+		"excerpt":        item.Excerpt,
+		"favorite":       boolString(item.Favorite),
+		"status":         item.Status,
+		"is_article":     boolString(item.IsArticle),
+		"has_image":      boolString(item.HasImage),
+		"has_video":      boolString(item.HasVideo),
+		"word_count":     strconv.Itoa(item.WordCount),
+		"lang":           item.Lang,
+		"time_added":     unixString(item.TimeAdded),
+		"time_updated":   unixString(item.TimeUpdated),
+		"time_read":      unixString(item.TimeRead),
+		"time_favorited": unixString(item.TimeFavorited),
+	}
+
+	if complete && len(item.Tags) > 0 {
+		tags := make(map[string]interface{}, len(item.Tags))
+		for _, tag := range item.Tags {
+			tags[tag] = map[string]interface{}{"item_id": item.ID, "tag": tag}
+		}
+		value["tags"] = tags
+	}
+
+	return value
+}
+
+func boolString(b bool) string {
+	if b {
+		return "1"
+	}
+
+	return "0"
+}
+
+func unixString(t time.Time) string {
+	if t.IsZero() {
+		return "0"
+	}
+
+	return strconv.FormatInt(t.Unix(), 10)
+}
+
+func host(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+}
+
+func splitTags(tags string) []string {
+	var result []string
+	for _, tag := range strings.Split(tags, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			result = append(result, tag)
+		}
+	}
+
+	return result
+}
+
+func containsTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+
+	return false
+}
+
+func mergeTags(tags, added []string) []string {
+	for _, tag := range added {
+		if !containsTag(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+
+	return tags
+}
+
+func removeTags(tags, removed []string) []string {
+	var result []string
+	for _, tag := range tags {
+		if !containsTag(removed, tag) {
+			result = append(result, tag)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,252 @@
+package pockettest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/tidwall/gjson"
+)
+
+// RecorderMode defines whether the Recorder replays the fixture or records a new one
+type RecorderMode int
+
+const (
+	// ModeReplay replays the recorded responses and fails on requests missing in the fixture
+	ModeReplay RecorderMode = iota
+	// ModeUpdate sends the requests to the real server and rewrites the fixture on Close
+	ModeUpdate
+)
+
+const scrubbedValue = "REDACTED"
+
+// scrubbedFields are removed from request and response bodies before they are saved to the fixture
+var scrubbedFields = []string{"consumer_key", "access_token"}
+
+// Recorder is an http.RoundTripper recording exchanges with the Pocket API to a fixture file and replaying them in tests.
+// The consumer key and access tokens are scrubbed from the fixture, and requests are matched by method, path and scrubbed body.
+//
+//	rec, err := pockettest.NewRecorder("testdata/retrieve.json", pockettest.ModeReplay, nil)
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	defer rec.Close()
+//
+//	client, _ := pocket.NewClient("consumer-key", pocket.WithHTTPClient(&http.Client{Transport: rec}))
+type Recorder struct {
+	mu           sync.Mutex
+	path         string
+	mode         RecorderMode
+	next         http.RoundTripper
+	interactions []*interaction
+}
+
+type interaction struct {
+	Request  recordedRequest  `json:"request"`
+	Response recordedResponse `json:"response"`
+	used     bool
+}
+
+type recordedRequest struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	Body   string `json:"body"`
+}
+
+type recordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body"`
+}
+
+type fixture struct {
+	Interactions []*interaction `json:"interactions"`
+}
+
+// NewRecorder creates a Recorder for the fixture file. In ModeReplay the fixture is loaded immediately,
+// in ModeUpdate the requests are sent with next (http.DefaultTransport if nil)
+func NewRecorder(path string, mode RecorderMode, next http.RoundTripper) (*Recorder, error) {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	r := &Recorder{path: path, mode: mode, next: next}
+	if mode == ModeUpdate {
+		return r, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("pockettest: error occurred when reading the fixture: %w", err)
+	}
+
+	var f fixture
+	if err = json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("pockettest: error occurred when parsing the fixture: %w", err)
+	}
+	r.interactions = f.Interactions
+
+	return r, nil
+}
+
+// RoundTrip replays the recorded response to the request, or sends it and records the exchange in ModeUpdate
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = io.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+	}
+
+	recorded := recordedRequest{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Body:   scrub(body),
+	}
+
+	if r.mode == ModeUpdate {
+		return r.record(req, body, recorded)
+	}
+
+	return r.replay(req, recorded)
+}
+
+func (r *Recorder) replay(req *http.Request, recorded recordedRequest) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, i := range r.interactions {
+		if i.used || i.Request != recorded {
+			continue
+		}
+
+		i.used = true
+		return i.Response.toHTTP(req), nil
+	}
+
+	return nil, fmt.Errorf("pockettest: no recorded interaction for %s %s %s in %s (record it with ModeUpdate)",
+		recorded.Method, recorded.Path, recorded.Body, r.path)
+}
+
+func (r *Recorder) record(req *http.Request, body []byte, recorded recordedRequest) (*http.Response, error) {
+	outReq := req.Clone(req.Context())
+	outReq.Body = io.NopCloser(bytes.NewReader(body))
+	outReq.ContentLength = int64(len(body))
+
+	resp, err := r.next.RoundTrip(outReq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	header := resp.Header.Clone()
+	header.Del("Date")
+	header.Del("Set-Cookie")
+
+	response := recordedResponse{
+		StatusCode: resp.StatusCode,
+		Header:     header,
+		Body:       scrub(respBody),
+	}
+
+	r.mu.Lock()
+	r.interactions = append(r.interactions, &interaction{Request: recorded, Response: response, used: true})
+	r.mu.Unlock()
+
+	// the caller gets the real response, only the fixture is scrubbed
+	response.Body = string(respBody)
+	return response.toHTTP(req), nil
+}
+
+// Unused returns the recorded requests that have not been replayed, which usually means that the tested code changed
+func (r *Recorder) Unused() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var unused []string
+	for _, i := range r.interactions {
+		if !i.used {
+			unused = append(unused, fmt.Sprintf("%s %s %s", i.Request.Method, i.Request.Path, i.Request.Body))
+		}
+	}
+
+	return unused
+}
+
+// Close writes the fixture in ModeUpdate and does nothing in ModeReplay
+func (r *Recorder) Close() error {
+	if r.mode != ModeUpdate {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	data, err := json.MarshalIndent(fixture{Interactions: r.interactions}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("pockettest: error occurred when marshal the fixture: %w", err)
+	}
+
+	if err = os.WriteFile(r.path, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("pockettest: error occurred when writing the fixture: %w", err)
+	}
+
+	return nil
+}
+
+func (r recordedResponse) toHTTP(req *http.Request) *http.Response {
+	header := r.Header.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", r.StatusCode, http.StatusText(r.StatusCode)),
+		StatusCode:    r.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(r.Body)),
+		ContentLength: int64(len(r.Body)),
+		Request:       req,
+	}
+}
+
+// scrub replaces the values of the secret fields of a JSON object with a placeholder in place,
+// keeping the rest of the body byte for byte (bodies without secrets are returned as is)
+func scrub(body []byte) string {
+	if !gjson.ValidBytes(body) {
+		return string(body)
+	}
+
+	var secrets []gjson.Result
+	for _, field := range scrubbedFields {
+		if value := gjson.GetBytes(body, field); value.Exists() && value.Index > 0 {
+			secrets = append(secrets, value)
+		}
+	}
+
+	sort.Slice(secrets, func(i, j int) bool { return secrets[i].Index > secrets[j].Index })
+
+	scrubbed := string(body)
+	for _, secret := range secrets {
+		scrubbed = scrubbed[:secret.Index] + strconv.Quote(scrubbedValue) + scrubbed[secret.Index+len(secret.Raw):]
+	}
+
+	return scrubbed
+}
@@ -0,0 +1,147 @@
+package pockettest_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	pocket "github.com/Lapp-coder/go-pocket-sdk"
+	"github.com/Lapp-coder/go-pocket-sdk/pockettest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecorder(t *testing.T) {
+	fixture := filepath.Join(t.TempDir(), "fixture.json")
+	ctx := context.Background()
+
+	run := func(baseURL string, rec *pockettest.Recorder) ([]string, error) {
+		client, err := pocket.NewClient("consumer-key",
+			pocket.WithBaseURL(baseURL),
+			pocket.WithHTTPClient(&http.Client{Transport: rec}),
+			pocket.WithRetryPolicy(pocket.RetryPolicy{MaxAttempts: 1}),
+		)
+		assert.NoError(t, err)
+
+		user := client.ForUser(pocket.Authorization{AccessToken: "access-token-1"})
+		if _, err = user.Add(ctx, pocket.AddInput{URL: "https://github.com", Title: "GitHub"}); err != nil {
+			return nil, err
+		}
+
+		result, err := user.Retrieve(ctx, pocket.RetrievingInput{Count: 10})
+		if err != nil {
+			return nil, err
+		}
+
+		return ids(result.Items), nil
+	}
+
+	c := &clock{now: time.Date(2021, 9, 23, 12, 0, 0, 0, time.UTC)}
+	srv := pockettest.NewServer("consumer-key", pockettest.WithClock(c.Now))
+	srv.AddUser("pocket-user")
+
+	rec, err := pockettest.NewRecorder(fixture, pockettest.ModeUpdate, nil)
+	assert.NoError(t, err)
+
+	recorded, err := run(srv.URL, rec)
+	assert.NoError(t, err)
+	assert.Len(t, recorded, 1)
+	assert.NoError(t, rec.Close())
+	srv.Close()
+
+	data, err := os.ReadFile(fixture)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), "consumer-key")
+	assert.NotContains(t, string(data), "access-token-1")
+
+	rec, err = pockettest.NewRecorder(fixture, pockettest.ModeReplay, nil)
+	assert.NoError(t, err)
+
+	replayed, err := run(srv.URL, rec)
+	assert.NoError(t, err)
+	assert.Equal(t, recorded, replayed)
+	assert.Empty(t, rec.Unused())
+
+	rec, err = pockettest.NewRecorder(fixture, pockettest.ModeReplay, nil)
+	assert.NoError(t, err)
+
+	client, err := pocket.NewClient("consumer-key", pocket.WithBaseURL(srv.URL), pocket.WithHTTPClient(&http.Client{Transport: rec}))
+	assert.NoError(t, err)
+
+	_, err = client.Get(ctx, pocket.RetrievingInput{AccessToken: "access-token-1", Search: "unknown"})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "no recorded interaction for POST /v3/get")
+	}
+	assert.Len(t, rec.Unused(), 2)
+}
+
+func TestNewRecorder_MissingFixture(t *testing.T) {
+	_, err := pockettest.NewRecorder(filepath.Join(t.TempDir(), "missing.json"), pockettest.ModeReplay, nil)
+	assert.Error(t, err)
+}
+
+type roundTripFunc func(r *http.Request) (*http.Response, error)
+
+func (rt roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return rt(r)
+}
+
+func TestRecorder_KeepsResponseBody(t *testing.T) {
+	testCases := []struct {
+		name             string
+		path             string
+		responseBody     string
+		expectedReplayed string
+	}{
+		{
+			name:             "Unsorted list",
+			path:             "/v3/get",
+			responseBody:     `{"status":1,"list":{"3":{"item_id":"3"},"1":{"item_id":"1"},"2":{"item_id":"2"}},"since":1632410435}`,
+			expectedReplayed: `{"status":1,"list":{"3":{"item_id":"3"},"1":{"item_id":"1"},"2":{"item_id":"2"}},"since":1632410435}`,
+		},
+		{
+			name:             "Scrubbed access token",
+			path:             "/v3/oauth/authorize",
+			responseBody:     `{"username":"pocket-user","access_token":"access-token-1","state":""}`,
+			expectedReplayed: `{"username":"pocket-user","access_token":"REDACTED","state":""}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fixture := filepath.Join(t.TempDir(), "fixture.json")
+			requestBody := `{"consumer_key":"consumer-key","access_token":"access-token-1","count":10}`
+
+			send := func(rec *pockettest.Recorder) string {
+				req, err := http.NewRequest(http.MethodPost, "http://pocket.test"+tc.path, strings.NewReader(requestBody))
+				assert.NoError(t, err)
+
+				resp, err := rec.RoundTrip(req)
+				if !assert.NoError(t, err) {
+					return ""
+				}
+				defer resp.Body.Close()
+
+				b, err := io.ReadAll(resp.Body)
+				assert.NoError(t, err)
+
+				return string(b)
+			}
+
+			rec, err := pockettest.NewRecorder(fixture, pockettest.ModeUpdate, roundTripFunc(func(r *http.Request) (*http.Response, error) {
+				return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(tc.responseBody))}, nil
+			}))
+			assert.NoError(t, err)
+			assert.Equal(t, tc.responseBody, send(rec))
+			assert.NoError(t, rec.Close())
+
+			rec, err = pockettest.NewRecorder(fixture, pockettest.ModeReplay, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedReplayed, send(rec))
+		})
+	}
+}
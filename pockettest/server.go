@@ -0,0 +1,302 @@
+// Package pockettest provides an in-memory fake of the Pocket API for integration tests,
+// which lets the real Client run end to end without network access.
+//
+//	srv := pockettest.NewServer("consumer-key")
+//	defer srv.Close()
+//
+//	accessToken := srv.AddUser("pocket-user")
+//	client, _ := pocket.NewClient("consumer-key", pocket.WithBaseURL(srv.URL))
+package pockettest
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"time"
+
+	pocket "github.com/Lapp-coder/go-pocket-sdk"
+)
+
+const (
+	endpointAdd              = "/v3/add"
+	endpointModify           = "/v3/send"
+	endpointRetrieving       = "/v3/get"
+	endpointRequestToken     = "/v3/oauth/request"
+	endpointRequestAuthorize = "/v3/oauth/authorize"
+
+	xErrorHeader     = "X-Error"
+	xErrorCodeHeader = "X-Error-Code"
+)
+
+// Server is a fake Pocket server keeping users, request tokens and items in memory.
+// The embedded httptest.Server provides URL (to pass to pocket.WithBaseURL) and Close
+type Server struct {
+	*httptest.Server
+
+	mu            sync.Mutex
+	consumerKey   string
+	now           func() time.Time
+	users         map[string]*user
+	requestTokens map[string]*requestToken
+	lastID        int
+	requests      map[string]int
+	faults        []*InjectedFault
+	rand          *rand.Rand
+}
+
+// Option configures the Server created by NewServer
+type Option func(s *Server)
+
+// WithRandSeed sets the seed of the random source used for probabilistic faults, so the injected failures are reproducible
+func WithRandSeed(seed int64) Option {
+	return func(s *Server) {
+		s.rand = rand.New(rand.NewSource(seed))
+	}
+}
+
+// WithClock sets the source of the current time used for timestamps of items and the since parameter (default is time.Now)
+func WithClock(now func() time.Time) Option {
+	return func(s *Server) {
+		s.now = now
+	}
+}
+
+type user struct {
+	username    string
+	accessToken string
+	items       []*Item
+}
+
+type requestToken struct {
+	code        string
+	redirectURI string
+	state       string
+	username    string
+	rejected    bool
+	used        bool
+}
+
+// request contains the fields of all Pocket API requests
+type request struct {
+	ConsumerKey string   `json:"consumer_key"`
+	AccessToken string   `json:"access_token"`
+	RedirectURI string   `json:"redirect_uri"`
+	Code        string   `json:"code"`
+	State       string   `json:"state"`
+	URL         string   `json:"url"`
+	Title       string   `json:"title"`
+	Tags        string   `json:"tags"`
+	TweetID     string   `json:"tweet_id"`
+	Actions     []action `json:"actions"`
+	Favorite    string   `json:"favorite"`
+	Tag         string   `json:"tag"`
+	ContentType string   `json:"contentType"`
+	Sort        string   `json:"sort"`
+	DetailType  string   `json:"detailType"`
+	Search      string   `json:"search"`
+	Domain      string   `json:"domain"`
+	Since       int64    `json:"since"`
+	Count       int      `json:"count"`
+	Offset      int      `json:"offset"`
+}
+
+// NewServer starts a fake Pocket server accepting the consumer key
+func NewServer(consumerKey string, opts ...Option) *Server {
+	s := &Server{
+		consumerKey:   consumerKey,
+		now:           time.Now,
+		users:         make(map[string]*user),
+		requestTokens: make(map[string]*requestToken),
+		requests:      make(map[string]int),
+		rand:          rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// AddUser creates a user (if it does not exist yet) and returns its access token
+func (s *Server) AddUser(username string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.addUser(username).accessToken
+}
+
+func (s *Server) addUser(username string) *user {
+	for _, u := range s.users {
+		if u.username == username {
+			return u
+		}
+	}
+
+	u := &user{
+		username:    username,
+		accessToken: fmt.Sprintf("access-token-%d", len(s.users)+1),
+	}
+	s.users[u.accessToken] = u
+
+	return u
+}
+
+// ApproveRequestToken simulates the user granting access rights to the application on the authorization page
+func (s *Server) ApproveRequestToken(code, username string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	token, ok := s.requestTokens[code]
+	if !ok {
+		return fmt.Errorf("request token %q not found", code)
+	}
+
+	s.addUser(username)
+	token.username = username
+	token.rejected = false
+
+	return nil
+}
+
+// RejectRequestToken simulates the user denying access rights to the application on the authorization page
+func (s *Server) RejectRequestToken(code string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	token, ok := s.requestTokens[code]
+	if !ok {
+		return fmt.Errorf("request token %q not found", code)
+	}
+
+	token.rejected = true
+	return nil
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, 0, "Method not allowed")
+		return
+	}
+
+	s.mu.Lock()
+	fault := s.fault(r.URL.Path)
+	s.mu.Unlock()
+
+	if fault != nil && fault.apply(w, r) {
+		return
+	}
+
+	var req request
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, 0, "Invalid request body")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if req.ConsumerKey == "" {
+		writeError(w, http.StatusBadRequest, pocket.ErrorCodeMissingConsumerKey, "Missing consumer key.")
+		return
+	}
+
+	if req.ConsumerKey != s.consumerKey {
+		writeError(w, http.StatusForbidden, pocket.ErrorCodeInvalidConsumerKey, "Invalid consumer key.")
+		return
+	}
+
+	switch r.URL.Path {
+	case endpointRequestToken:
+		s.handleRequestToken(w, req)
+	case endpointRequestAuthorize:
+		s.handleAuthorize(w, req)
+	case endpointAdd, endpointModify, endpointRetrieving:
+		u, ok := s.users[req.AccessToken]
+		if !ok {
+			writeError(w, http.StatusUnauthorized, pocket.ErrorCodeInvalidAccessToken, "Invalid access token.")
+			return
+		}
+
+		switch r.URL.Path {
+		case endpointAdd:
+			s.handleAdd(w, u, req)
+		case endpointModify:
+			s.handleModify(w, u, req)
+		default:
+			s.handleRetrieving(w, u, req)
+		}
+	default:
+		writeError(w, http.StatusNotFound, 0, "Not found")
+	}
+}
+
+func (s *Server) handleRequestToken(w http.ResponseWriter, req request) {
+	if req.RedirectURI == "" {
+		writeError(w, http.StatusBadRequest, pocket.ErrorCodeInvalidRedirectURI, "Invalid redirect uri.")
+		return
+	}
+
+	token := &requestToken{
+		code:        fmt.Sprintf("request-token-%d", len(s.requestTokens)+1),
+		redirectURI: req.RedirectURI,
+		state:       req.State,
+	}
+	s.requestTokens[token.code] = token
+
+	writeJSON(w, map[string]interface{}{"code": token.code, "state": nullable(token.state)})
+}
+
+func (s *Server) handleAuthorize(w http.ResponseWriter, req request) {
+	if req.Code == "" {
+		writeError(w, http.StatusBadRequest, pocket.ErrorCodeMissingCode, "Missing code.")
+		return
+	}
+
+	token, ok := s.requestTokens[req.Code]
+	if !ok {
+		writeError(w, http.StatusBadRequest, pocket.ErrorCodeCodeNotFound, "Code not found.")
+		return
+	}
+
+	if token.used {
+		writeError(w, http.StatusForbidden, pocket.ErrorCodeAlreadyUsedCode, "Already used code.")
+		return
+	}
+
+	if token.rejected || token.username == "" {
+		writeError(w, http.StatusForbidden, pocket.ErrorCodeUserRejectedCode, "User rejected code.")
+		return
+	}
+
+	token.used = true
+	u := s.addUser(token.username)
+
+	writeJSON(w, map[string]interface{}{"access_token": u.accessToken, "username": u.username, "state": nullable(token.state)})
+}
+
+func writeJSON(w http.ResponseWriter, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, statusCode, code int, message string) {
+	if code != 0 {
+		w.Header().Set(xErrorCodeHeader, strconv.Itoa(code))
+	}
+	w.Header().Set(xErrorHeader, message)
+	w.WriteHeader(statusCode)
+}
+
+func nullable(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+
+	return s
+}
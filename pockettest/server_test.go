@@ -0,0 +1,194 @@
+package pockettest_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	pocket "github.com/Lapp-coder/go-pocket-sdk"
+	"github.com/Lapp-coder/go-pocket-sdk/pockettest"
+	"github.com/stretchr/testify/assert"
+)
+
+type clock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *clock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+func newClient(t *testing.T, srv *pockettest.Server, consumerKey string) *pocket.Client {
+	client, err := pocket.NewClient(consumerKey, pocket.WithBaseURL(srv.URL))
+	assert.NoError(t, err)
+
+	return client
+}
+
+func TestServer_OAuth(t *testing.T) {
+	srv := pockettest.NewServer("consumer-key")
+	defer srv.Close()
+
+	ctx := context.Background()
+	client := newClient(t, srv, "consumer-key")
+
+	requestToken, err := client.GetRequestToken(ctx, "http://localhost/callback", "testing")
+	assert.NoError(t, err)
+
+	_, err = client.Authorize(ctx, requestToken.Code)
+	assert.True(t, pocket.IsAuthError(err))
+
+	assert.NoError(t, srv.ApproveRequestToken(requestToken.Code, "pocket-user"))
+
+	auth, err := client.Authorize(ctx, requestToken.Code)
+	assert.NoError(t, err)
+	assert.Equal(t, srv.AddUser("pocket-user"), auth.AccessToken)
+	assert.Equal(t, "pocket-user", auth.Username)
+	assert.Equal(t, "testing", auth.State)
+
+	_, err = client.Authorize(ctx, requestToken.Code)
+	assert.True(t, pocket.IsAuthError(err))
+
+	_, err = newClient(t, srv, "wrong-key").GetRequestToken(ctx, "http://localhost/callback", "")
+	var apiErr *pocket.APIError
+	assert.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, pocket.ErrorCodeInvalidConsumerKey, apiErr.Code)
+}
+
+func TestServer_Items(t *testing.T) {
+	c := &clock{now: time.Date(2021, 9, 23, 12, 0, 0, 0, time.UTC)}
+	srv := pockettest.NewServer("consumer-key", pockettest.WithClock(c.Now))
+	defer srv.Close()
+
+	ctx := context.Background()
+	client := newClient(t, srv, "consumer-key")
+	accessToken := srv.AddUser("pocket-user")
+	user := client.ForUser(pocket.Authorization{AccessToken: accessToken})
+
+	github, err := user.Add(ctx, pocket.AddInput{URL: "https://github.com", Title: "GitHub", Tags: []string{"code"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "GitHub", github.ResolvedTitle)
+
+	c.Advance(time.Minute)
+	golang, err := user.Add(ctx, pocket.AddInput{URL: "https://golang.org", Title: "Go"})
+	assert.NoError(t, err)
+
+	c.Advance(time.Minute)
+	result, err := user.Retrieve(ctx, pocket.RetrievingInput{Sort: pocket.SortNewest, DetailType: pocket.DetailTypeComplete})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{golang.ID, github.ID}, ids(result.Items))
+	assert.Equal(t, []string{"code"}, result.Items[1].Tags)
+
+	syncer := client.NewSyncer(accessToken, result.Since)
+
+	modifyResult, err := user.Modify(ctx,
+		pocket.NewArchiveAction(github.ID),
+		pocket.NewTagsAddAction(golang.ID, "go"),
+		pocket.NewFavoriteAction("unknown"),
+	)
+	assert.NoError(t, err)
+	assert.Len(t, modifyResult.Failed(), 1)
+	assert.Equal(t, "Invalid item id", modifyResult.Failed()[0].Error.Message)
+
+	result, err = user.Retrieve(ctx, pocket.RetrievingInput{State: pocket.StateArchive})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{github.ID}, ids(result.Items))
+
+	result, err = user.Retrieve(ctx, pocket.RetrievingInput{Tag: "go"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{golang.ID}, ids(result.Items))
+
+	c.Advance(time.Minute)
+	_, err = user.Delete(ctx, golang.ID)
+	assert.NoError(t, err)
+
+	changes, err := syncer.Sync(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{github.ID}, ids(changes.Archived))
+	assert.Equal(t, []string{golang.ID}, ids(changes.Deleted))
+	assert.Empty(t, changes.Added)
+
+	it := user.Items(ctx, pocket.RetrievingInput{State: pocket.StateAll}, pocket.WithPageSize(1))
+	var all []pocket.Item
+	for it.Next() {
+		all = append(all, it.Item())
+	}
+	assert.NoError(t, it.Err())
+	assert.Equal(t, []string{github.ID}, ids(all))
+
+	_, err = client.Retrieving(ctx, pocket.RetrievingInput{AccessToken: "wrong-token"})
+	assert.True(t, pocket.IsAuthError(err))
+}
+
+func TestServer_Sync(t *testing.T) {
+	c := &clock{now: time.Date(2021, 9, 23, 12, 0, 0, 0, time.UTC)}
+	srv := pockettest.NewServer("consumer-key", pockettest.WithClock(c.Now))
+	defer srv.Close()
+
+	ctx := context.Background()
+	client := newClient(t, srv, "consumer-key")
+	accessToken := srv.AddUser("pocket-user")
+	user := client.ForUser(pocket.Authorization{AccessToken: accessToken})
+
+	github, err := user.Add(ctx, pocket.AddInput{URL: "https://github.com"})
+	assert.NoError(t, err)
+	golang, err := user.Add(ctx, pocket.AddInput{URL: "https://golang.org"})
+	assert.NoError(t, err)
+
+	result, err := user.Retrieve(ctx, pocket.RetrievingInput{Count: 1})
+	assert.NoError(t, err)
+	assert.False(t, result.Complete)
+
+	result, err = user.Retrieve(ctx, pocket.RetrievingInput{Count: 1, Offset: 1})
+	assert.NoError(t, err)
+	assert.True(t, result.Complete)
+
+	syncer := client.NewSyncer(accessToken, time.Time{})
+
+	c.Advance(time.Minute)
+	changes, err := syncer.Sync(ctx)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{github.ID, golang.ID}, ids(changes.Added))
+	assert.Equal(t, c.Now(), syncer.Since())
+
+	c.Advance(time.Minute)
+	_, err = user.Archive(ctx, github.ID)
+	assert.NoError(t, err)
+	c.Advance(time.Minute)
+	example, err := user.Add(ctx, pocket.AddInput{URL: "https://example.com"})
+	assert.NoError(t, err)
+
+	c.Advance(time.Minute)
+	changes, err = syncer.Sync(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{example.ID}, ids(changes.Added))
+	assert.Equal(t, []string{github.ID}, ids(changes.Archived))
+	assert.Empty(t, changes.Updated)
+
+	c.Advance(time.Minute)
+	changes, err = syncer.Sync(ctx)
+	assert.NoError(t, err)
+	assert.True(t, changes.Empty())
+	assert.Equal(t, c.Now(), syncer.Since())
+}
+
+func ids(items []pocket.Item) []string {
+	var result []string
+	for _, item := range items {
+		result = append(result, item.ID)
+	}
+
+	return result
+}
@@ -0,0 +1,187 @@
+package go_pocket_sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	xLimitUserLimitHeader     = "X-Limit-User-Limit"
+	xLimitUserRemainingHeader = "X-Limit-User-Remaining"
+	xLimitUserResetHeader     = "X-Limit-User-Reset"
+	xLimitKeyLimitHeader      = "X-Limit-Key-Limit"
+	xLimitKeyRemainingHeader  = "X-Limit-Key-Remaining"
+	xLimitKeyResetHeader      = "X-Limit-Key-Reset"
+
+	RateLimitScopeUser = "user"
+	RateLimitScopeKey  = "key"
+
+	// rateLimitSweepInterval is how often the quotas of access tokens that have been reset are removed
+	rateLimitSweepInterval = time.Minute
+)
+
+// RateLimitPolicy defines what the client does before sending a request that would exceed the remaining rate limit
+type RateLimitPolicy int
+
+const (
+	// RateLimitIgnore sends requests regardless of the remaining rate limit (default)
+	RateLimitIgnore RateLimitPolicy = iota
+	// RateLimitWait blocks until the rate limit is reset or the context is done
+	RateLimitWait
+	// RateLimitFailFast returns a *RateLimitError without sending the request
+	RateLimitFailFast
+)
+
+// RateLimit contains the latest quota reported by Pocket. A zero Limit means that the quota is not known yet
+type RateLimit struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
+
+// RateLimits contains the latest quotas for an access token (User) and for the consumer key of the client (Key)
+type RateLimits struct {
+	User RateLimit
+	Key  RateLimit
+}
+
+// RateLimitError is returned when a request is not sent because the rate limit is exhausted (see RateLimitFailFast)
+type RateLimitError struct {
+	Scope string
+	Reset time.Time
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("rate limit of the %s exceeded, resets at %s", e.Scope, e.Reset.Format(time.RFC3339))
+}
+
+func (l RateLimit) exhausted(now time.Time) bool {
+	return l.Limit > 0 && l.Remaining <= 0 && now.Before(l.Reset)
+}
+
+type rateLimiter struct {
+	mu        sync.Mutex
+	users     map[string]RateLimit
+	key       RateLimit
+	nextSweep time.Time
+}
+
+func (l *rateLimiter) get(accessToken string) RateLimits {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return RateLimits{User: l.users[accessToken], Key: l.key}
+}
+
+func (l *rateLimiter) update(accessToken string, header http.Header) {
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// the quotas of access tokens are kept only until their reset, so the map does not grow with every user of the client
+	if now.After(l.nextSweep) {
+		for token, limit := range l.users {
+			if !now.Before(limit.Reset) {
+				delete(l.users, token)
+			}
+		}
+		l.nextSweep = now.Add(rateLimitSweepInterval)
+	}
+
+	if limit, ok := parseRateLimit(header, xLimitUserLimitHeader, xLimitUserRemainingHeader, xLimitUserResetHeader, now); ok && accessToken != "" {
+		if l.users == nil {
+			l.users = make(map[string]RateLimit)
+		}
+		l.users[accessToken] = limit
+	}
+
+	if limit, ok := parseRateLimit(header, xLimitKeyLimitHeader, xLimitKeyRemainingHeader, xLimitKeyResetHeader, now); ok {
+		l.key = limit
+	}
+}
+
+// exceeded returns an error describing the exhausted quota of the access token or the consumer key, if any
+func (l *rateLimiter) exceeded(accessToken string) *RateLimitError {
+	limits := l.get(accessToken)
+	now := time.Now()
+
+	if limits.User.exhausted(now) {
+		return &RateLimitError{Scope: RateLimitScopeUser, Reset: limits.User.Reset}
+	}
+
+	if limits.Key.exhausted(now) {
+		return &RateLimitError{Scope: RateLimitScopeKey, Reset: limits.Key.Reset}
+	}
+
+	return nil
+}
+
+// wait applies the policy before sending a request on behalf of the access token
+func (l *rateLimiter) wait(ctx context.Context, policy RateLimitPolicy, accessToken string) error {
+	if policy == RateLimitIgnore {
+		return nil
+	}
+
+	for {
+		rateLimitErr := l.exceeded(accessToken)
+		if rateLimitErr == nil {
+			return nil
+		}
+
+		if policy == RateLimitFailFast {
+			return rateLimitErr
+		}
+
+		timer := time.NewTimer(time.Until(rateLimitErr.Reset))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func parseRateLimit(header http.Header, limitHeader, remainingHeader, resetHeader string, now time.Time) (RateLimit, bool) {
+	limit, err := strconv.Atoi(header.Get(limitHeader))
+	if err != nil {
+		return RateLimit{}, false
+	}
+
+	remaining, err := strconv.Atoi(header.Get(remainingHeader))
+	if err != nil {
+		return RateLimit{}, false
+	}
+
+	reset, _ := strconv.Atoi(header.Get(resetHeader))
+
+	return RateLimit{
+		Limit:     limit,
+		Remaining: remaining,
+		Reset:     now.Add(time.Duration(reset) * time.Second),
+	}, true
+}
+
+// RateLimit returns the latest quotas reported by Pocket for the access token and for the consumer key of the client.
+// The quota of an access token may be forgotten (reported as zero) after its reset time
+func (c *Client) RateLimit(accessToken string) RateLimits {
+	return c.limits.get(accessToken)
+}
+
+func accessTokenOf(body interface{}) string {
+	switch b := body.(type) {
+	case requestAdd:
+		return b.AccessToken
+	case requestModify:
+		return b.AccessToken
+	case requestRetrieving:
+		return b.AccessToken
+	}
+
+	return ""
+}
@@ -0,0 +1,99 @@
+package go_pocket_sdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_RateLimit(t *testing.T) {
+	header := http.Header{
+		xLimitUserLimitHeader:     {"320"},
+		xLimitUserRemainingHeader: {"0"},
+		xLimitUserResetHeader:     {"60"},
+		xLimitKeyLimitHeader:      {"10000"},
+		xLimitKeyRemainingHeader:  {"9999"},
+		xLimitKeyResetHeader:      {"3600"},
+	}
+	response := stubResponse{statusCode: http.StatusOK, header: header, body: `{"status":1,"list":{}}`}
+	input := RetrievingInput{AccessToken: "access-token"}
+
+	t.Run("Tracks quotas", func(t *testing.T) {
+		var calls int
+		client := newTestClient(t, scriptedTransport(&calls, response), WithRateLimitPolicy(RateLimitIgnore))
+
+		_, err := client.Retrieving(context.Background(), input)
+		assert.NoError(t, err)
+
+		limits := client.RateLimit("access-token")
+		assert.Equal(t, 320, limits.User.Limit)
+		assert.Equal(t, 0, limits.User.Remaining)
+		assert.WithinDuration(t, time.Now().Add(time.Minute), limits.User.Reset, time.Second)
+		assert.Equal(t, 10000, limits.Key.Limit)
+		assert.Equal(t, 9999, limits.Key.Remaining)
+		assert.Equal(t, RateLimit{}, client.RateLimit("other-token").User)
+
+		_, err = client.Retrieving(context.Background(), input)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("Fail fast", func(t *testing.T) {
+		var calls int
+		client := newTestClient(t, scriptedTransport(&calls, response), WithRateLimitPolicy(RateLimitFailFast))
+
+		_, err := client.Retrieving(context.Background(), input)
+		assert.NoError(t, err)
+
+		_, err = client.Retrieving(context.Background(), input)
+		var rateLimitErr *RateLimitError
+		assert.True(t, errors.As(err, &rateLimitErr))
+		assert.Equal(t, RateLimitScopeUser, rateLimitErr.Scope)
+		assert.True(t, IsRateLimited(err))
+		assert.Equal(t, 1, calls)
+
+		_, err = client.Retrieving(context.Background(), RetrievingInput{AccessToken: "other-token"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Wait", func(t *testing.T) {
+		var calls int
+		client := newTestClient(t, scriptedTransport(&calls, response), WithRateLimitPolicy(RateLimitWait))
+
+		_, err := client.Retrieving(context.Background(), input)
+		assert.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		_, err = client.Retrieving(ctx, input)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, 1, calls)
+	})
+}
+
+func TestRateLimiter_update_RemovesResetQuotas(t *testing.T) {
+	var l rateLimiter
+	l.update("expired-token", http.Header{
+		xLimitUserLimitHeader:     {"320"},
+		xLimitUserRemainingHeader: {"0"},
+		xLimitUserResetHeader:     {"0"},
+	})
+	assert.Len(t, l.users, 1)
+
+	// the next sweep is due
+	l.nextSweep = time.Time{}
+	l.update("access-token", http.Header{
+		xLimitUserLimitHeader:     {"320"},
+		xLimitUserRemainingHeader: {"319"},
+		xLimitUserResetHeader:     {"60"},
+	})
+
+	assert.Len(t, l.users, 1)
+	assert.Equal(t, RateLimit{}, l.get("expired-token").User)
+	assert.Equal(t, 319, l.get("access-token").User.Remaining)
+}
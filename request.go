@@ -33,9 +33,9 @@ type (
 		State       string `json:"state,omitempty"`
 		Favorite    string `json:"favorite,omitempty"`
 		Tag         string `json:"tag,omitempty"`
-		ContentType string `json:"content_type,omitempty"`
+		ContentType string `json:"contentType,omitempty"`
 		Sort        string `json:"sort,omitempty"`
-		DetailType  string `json:"detail_type,omitempty"`
+		DetailType  string `json:"detailType,omitempty"`
 		Search      string `json:"search,omitempty"`
 		Domain      string `json:"domain,omitempty"`
 		Since       int64  `json:"since,omitempty"`
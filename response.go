@@ -1,17 +1,105 @@
 package go_pocket_sdk
 
 import (
+	"errors"
 	"fmt"
+	"net/url"
+	"strings"
+	"time"
 
 	"github.com/tidwall/gjson"
 )
 
+// RequestToken is the request token (code) obtained by GetRequestToken together with the data of the authorization flow.
+// A token restored from storage (for example, between HTTP requests of a web application) needs only Code and RedirectURL
+type RequestToken struct {
+	Code        string
+	RedirectURL string
+	State       string
+
+	baseURL string
+}
+
 type Authorization struct {
 	AccessToken string
 	Username    string
 	State       string
 }
 
+// RetrieveResult contains the items and the metadata of the Retrieve API response
+type RetrieveResult struct {
+	Items []Item
+	// Status is 1 if items were found and 2 if the list is empty
+	Status int
+	// Complete is true if Pocket returned "complete": 1 (the field is not documented, a missing field reads as false)
+	Complete bool
+	// Since is the server time of the response, which should be passed to RetrievingInput.Since to get only the changes after it
+	Since      time.Time
+	SearchType string
+	Error      string
+	MaxActions int
+}
+
+// ModifyResult contains the results of the actions sent with Modify, in the same order as ModifyInput.Actions
+type ModifyResult struct {
+	Results []ActionResult
+}
+
+// ActionResult is the result of a single action
+type ActionResult struct {
+	Action  Action
+	Success bool
+	// Item is the added item (only for ActionAdd)
+	Item  *Item
+	Error *ActionError
+}
+
+// ActionError describes why Pocket failed to perform an action
+type ActionError struct {
+	Message string
+	Type    string
+	Code    int
+	// Err is the cause of the failure if the action was not performed by Pocket
+	// (ErrActionNotSent or the error of the request carrying the action), and nil otherwise
+	Err error
+}
+
+func (e *ActionError) Error() string {
+	return fmt.Sprintf("%s (code %d)", e.Message, e.Code)
+}
+
+// Unwrap returns the cause of the failure, so ActionError can be checked with errors.Is and errors.As
+func (e *ActionError) Unwrap() error {
+	return e.Err
+}
+
+// Failed returns the results of the failed actions
+func (r ModifyResult) Failed() []ActionResult {
+	var failed []ActionResult
+	for _, result := range r.Results {
+		if !result.Success {
+			failed = append(failed, result)
+		}
+	}
+
+	return failed
+}
+
+// Err returns an error describing the failed actions, or nil if all actions succeeded
+func (r ModifyResult) Err() error {
+	failed := r.Failed()
+	if len(failed) == 0 {
+		return nil
+	}
+
+	msg := fmt.Sprintf("%d of %d actions failed", len(failed), len(r.Results))
+	if failed[0].Error != nil {
+		msg += fmt.Sprintf(", first %q: %s", failed[0].Action.Name, failed[0].Error.Message)
+	}
+
+	return errors.New(msg)
+}
+
 type Item struct {
 	ID            string
 	ResolvedID    string
@@ -26,19 +114,240 @@ type Item struct {
 	HasImage      string
 	HasVideo      string
 	WordCount     string
+
+	TimeAdded              time.Time
+	TimeUpdated            time.Time
+	TimeRead               time.Time
+	TimeFavorited          time.Time
+	SortID                 int
+	Lang                   string
+	TimeToRead             int
+	ListenDurationEstimate int
+	TopImageURL            *url.URL
+	AmpURL                 *url.URL
+	DomainMetadata         DomainMetadata
+
+	// Tags, Authors, TopImage, Images and Videos are filled only when RetrievingInput.DetailType is "complete"
+	Tags     []string
+	Authors  []Author
+	TopImage *Image
+	Images   []Image
+	Videos   []Video
 }
 
-func (i *Item) fillAllFields(result gjson.Result) {
-	i.ResolvedID = result.Get(fmt.Sprintf("list.%s.resolved_id", i.ID)).String()
-	i.GivenURL = result.Get(fmt.Sprintf("list.%s.given_url", i.ID)).String()
-	i.ResolvedURL = result.Get(fmt.Sprintf("list.%s.resolved_url", i.ID)).String()
-	i.GivenTitle = result.Get(fmt.Sprintf("list.%s.given_title", i.ID)).String()
-	i.ResolvedTitle = result.Get(fmt.Sprintf("list.%s.resolved_title", i.ID)).String()
-	i.Favorite = result.Get(fmt.Sprintf("list.%s.favorite", i.ID)).String()
-	i.Status = result.Get(fmt.Sprintf("list.%s.status", i.ID)).String()
-	i.Excerpt = result.Get(fmt.Sprintf("list.%s.excerpt", i.ID)).String()
-	i.IsArticle = result.Get(fmt.Sprintf("list.%s.is_article", i.ID)).String()
-	i.HasImage = result.Get(fmt.Sprintf("list.%s.has_image", i.ID)).String()
-	i.HasVideo = result.Get(fmt.Sprintf("list.%s.has_video", i.ID)).String()
-	i.WordCount = result.Get(fmt.Sprintf("list.%s.word_count", i.ID)).String()
+// DomainMetadata contains information about the site of the item
+type DomainMetadata struct {
+	Name          string
+	Logo          string
+	GreyscaleLogo string
+}
+
+// Author is an author of the item
+type Author struct {
+	ID   string
+	Name string
+	URL  string
+}
+
+// Image is an image in the item
+type Image struct {
+	ID      string
+	Src     string
+	Width   int
+	Height  int
+	Credit  string
+	Caption string
+}
+
+// Video is a video in the item
+type Video struct {
+	ID     string
+	Src    string
+	Width  int
+	Height int
+	Type   int
+	VID    string
+	Length int
+}
+
+// parseItem parses a standalone item object, such as the one returned by the Add API
+func parseItem(value gjson.Result) Item {
+	if !value.IsObject() {
+		return Item{}
+	}
+
+	item := Item{ID: value.Get("item_id").String()}
+	item.fillAllFields(value)
+	return item
+}
+
+func (i *Item) fillAllFields(item gjson.Result) {
+	i.ResolvedID = item.Get("resolved_id").String()
+	i.GivenURL = item.Get("given_url").String()
+	i.ResolvedURL = item.Get("resolved_url").String()
+	i.GivenTitle = item.Get("given_title").String()
+	i.ResolvedTitle = item.Get("resolved_title").String()
+	if i.ResolvedTitle == "" {
+		// the Add API returns the resolved title in the "title" field
+		i.ResolvedTitle = item.Get("title").String()
+	}
+	i.Favorite = item.Get("favorite").String()
+	i.Status = item.Get("status").String()
+	i.Excerpt = item.Get("excerpt").String()
+	i.IsArticle = item.Get("is_article").String()
+	i.HasImage = item.Get("has_image").String()
+	i.HasVideo = item.Get("has_video").String()
+	i.WordCount = item.Get("word_count").String()
+
+	i.TimeAdded = parseTime(item.Get("time_added"))
+	i.TimeUpdated = parseTime(item.Get("time_updated"))
+	i.TimeRead = parseTime(item.Get("time_read"))
+	i.TimeFavorited = parseTime(item.Get("time_favorited"))
+	i.SortID = int(item.Get("sort_id").Int())
+	i.Lang = item.Get("lang").String()
+	i.TimeToRead = int(item.Get("time_to_read").Int())
+	i.ListenDurationEstimate = int(item.Get("listen_duration_estimate").Int())
+	i.TopImageURL = parseURL(item.Get("top_image_url"))
+	i.AmpURL = parseURL(item.Get("amp_url"))
+	i.DomainMetadata = DomainMetadata{
+		Name:          item.Get("domain_metadata.name").String(),
+		Logo:          item.Get("domain_metadata.logo").String(),
+		GreyscaleLogo: item.Get("domain_metadata.greyscale_logo").String(),
+	}
+
+	i.fillDetails(item)
+}
+
+// fillDetails fills the fields returned only for the "complete" detail type
+func (i *Item) fillDetails(item gjson.Result) {
+	item.Get("tags").ForEach(func(key, _ gjson.Result) bool {
+		i.Tags = append(i.Tags, key.String())
+		return true
+	})
+
+	item.Get("authors").ForEach(func(_, value gjson.Result) bool {
+		i.Authors = append(i.Authors, Author{
+			ID:   value.Get("author_id").String(),
+			Name: value.Get("name").String(),
+			URL:  value.Get("url").String(),
+		})
+		return true
+	})
+
+	if image := item.Get("image"); image.IsObject() {
+		topImage := parseImage(image)
+		i.TopImage = &topImage
+	}
+
+	item.Get("images").ForEach(func(_, value gjson.Result) bool {
+		i.Images = append(i.Images, parseImage(value))
+		return true
+	})
+
+	item.Get("videos").ForEach(func(_, value gjson.Result) bool {
+		i.Videos = append(i.Videos, Video{
+			ID:     value.Get("video_id").String(),
+			Src:    value.Get("src").String(),
+			Width:  int(value.Get("width").Int()),
+			Height: int(value.Get("height").Int()),
+			Type:   int(value.Get("type").Int()),
+			VID:    value.Get("vid").String(),
+			Length: int(value.Get("length").Int()),
+		})
+		return true
+	})
+}
+
+func parseImage(value gjson.Result) Image {
+	return Image{
+		ID:      value.Get("image_id").String(),
+		Src:     value.Get("src").String(),
+		Width:   int(value.Get("width").Int()),
+		Height:  int(value.Get("height").Int()),
+		Credit:  value.Get("credit").String(),
+		Caption: value.Get("caption").String(),
+	}
+}
+
+func parseModifyResult(actions []Action, result gjson.Result) ModifyResult {
+	actionResults := result.Get("action_results").Array()
+	actionErrors := result.Get("action_errors").Array()
+
+	// when Pocket does not report per-action results, the status of the whole batch is used
+	batchSucceeded := result.Get("status").Int() == 1
+
+	results := make([]ActionResult, len(actions))
+	for i, action := range actions {
+		results[i].Action = action
+		results[i].Success = batchSucceeded
+
+		if i < len(actionResults) {
+			if value := actionResults[i]; value.IsObject() {
+				item := parseItem(value)
+				results[i].Item = &item
+				results[i].Success = true
+			} else {
+				results[i].Success = value.Bool()
+			}
+		}
+
+		if i < len(actionErrors) && actionErrors[i].IsObject() {
+			results[i].Success = false
+			results[i].Error = &ActionError{
+				Message: actionErrors[i].Get("message").String(),
+				Type:    actionErrors[i].Get("type").String(),
+				Code:    int(actionErrors[i].Get("code").Int()),
+			}
+		}
+	}
+
+	return ModifyResult{Results: results}
+}
+
+// title returns the resolved title of the item, or the given title if the item has not been resolved
+func (i Item) title() string {
+	if i.ResolvedTitle != "" {
+		return strings.ToLower(i.ResolvedTitle)
+	}
+
+	return strings.ToLower(i.GivenTitle)
+}
+
+// site returns the host of the item URL without the "www." prefix
+func (i Item) site() string {
+	rawURL := i.ResolvedURL
+	if rawURL == "" {
+		rawURL = i.GivenURL
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+}
+
+// parseTime converts a unix timestamp returned by Pocket to time.Time, "0" and missing values become zero time
+func parseTime(value gjson.Result) time.Time {
+	sec := value.Int()
+	if sec == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(sec, 0).UTC()
+}
+
+// parseURL returns nil for empty and invalid URLs
+func parseURL(value gjson.Result) *url.URL {
+	if value.String() == "" {
+		return nil
+	}
+
+	u, err := url.Parse(value.String())
+	if err != nil {
+		return nil
+	}
+
+	return u
 }
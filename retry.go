@@ -0,0 +1,152 @@
+package go_pocket_sdk
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+const retryAfterHeader = "Retry-After"
+
+// RetryPolicy defines how the client retries requests that failed because of transient errors:
+// network errors, 5xx responses and rate limiting (429 or 403 with an exhausted quota).
+// Requests that add items (Add and Modify with ActionAdd) are not idempotent and are retried only if RetryNonIdempotent is set
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one (values less than 2 disable retries)
+	MaxAttempts int
+	// MinBackoff is the delay before the first retry, doubled for each subsequent retry
+	MinBackoff time.Duration
+	// MaxBackoff limits the delay between retries, zero means no limit.
+	// A rate-limited request is not retried if its quota is reset later than MaxBackoff
+	MaxBackoff time.Duration
+	// RetryNonIdempotent allows retrying requests that add items, which may lead to duplicates
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy is a reasonable retry policy for background jobs
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 4,
+	MinBackoff:  500 * time.Millisecond,
+	MaxBackoff:  30 * time.Second,
+}
+
+// backoff returns the delay before the given retry (starting from 1) with jitter in the range [d/2, d]
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	d := p.MinBackoff
+	for i := 1; i < retry && (p.MaxBackoff <= 0 || d < p.MaxBackoff); i++ {
+		d *= 2
+	}
+
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+
+	if d <= 0 {
+		return 0
+	}
+
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// delay returns the delay before the given retry of a request that failed with err,
+// and false if the request must not be retried
+func (p RetryPolicy) delay(retry int, err error) (time.Duration, bool) {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		if apiErr.isRateLimited() {
+			d := maxDuration(p.backoff(retry), rateLimitResetDelay(apiErr.Header))
+			return d, p.MaxBackoff <= 0 || d <= p.MaxBackoff
+		}
+
+		return p.backoff(retry), apiErr.isServerError()
+	}
+
+	if isTransientNetError(err) {
+		return p.backoff(retry), true
+	}
+
+	return 0, false
+}
+
+// isTransientNetError reports whether err is a network failure that may succeed on retry.
+// Every error of http.Client.Do implements net.Error, so TLS, proxy and other permanent failures are excluded explicitly
+func isTransientNetError(err error) bool {
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	return errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// rateLimitResetDelay returns the time until the exhausted quota is reset according to the response headers
+func rateLimitResetDelay(header http.Header) time.Duration {
+	var delay time.Duration
+	for _, h := range [][2]string{
+		{xLimitUserRemainingHeader, xLimitUserResetHeader},
+		{xLimitKeyRemainingHeader, xLimitKeyResetHeader},
+		{"", retryAfterHeader},
+	} {
+		if h[0] != "" && header.Get(h[0]) != "0" {
+			continue
+		}
+
+		if seconds, err := strconv.Atoi(header.Get(h[1])); err == nil {
+			delay = maxDuration(delay, time.Duration(seconds)*time.Second)
+		}
+	}
+
+	return delay
+}
+
+func maxDuration(a, b time.Duration) time.Duration {
+	if a > b {
+		return a
+	}
+
+	return b
+}
+
+// sleep waits for the delay, returning false if the context is done earlier or its deadline does not leave time for it
+func sleep(ctx context.Context, delay time.Duration) bool {
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+		return false
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// isIdempotent reports whether the request can be safely sent more than once
+func isIdempotent(endpoint string, body interface{}) bool {
+	switch endpoint {
+	case endpointAdd:
+		return false
+	case endpointModify:
+		if b, ok := body.(requestModify); ok {
+			for _, action := range b.Actions {
+				if action.Name == ActionAdd {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,234 @@
+package go_pocket_sdk
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Retry(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond}
+	ok := stubResponse{statusCode: http.StatusOK, body: `{"status":1,"list":{}}`}
+
+	testCases := []struct {
+		name          string
+		policy        RetryPolicy
+		responses     []stubResponse
+		call          func(ctx context.Context, c *Client) error
+		timeout       time.Duration
+		expectedCalls int
+		wantErr       bool
+	}{
+		{
+			name:          "OK_RetriedServerError",
+			policy:        policy,
+			responses:     []stubResponse{{statusCode: 503}, ok},
+			expectedCalls: 2,
+			wantErr:       false,
+		},
+		{
+			name:          "OK_RetriedNetworkError",
+			policy:        policy,
+			responses:     []stubResponse{{err: syscall.ECONNRESET}, ok},
+			expectedCalls: 2,
+			wantErr:       false,
+		},
+		{
+			name:          "OK_RetriedUnexpectedEOF",
+			policy:        policy,
+			responses:     []stubResponse{{err: io.ErrUnexpectedEOF}, ok},
+			expectedCalls: 2,
+			wantErr:       false,
+		},
+		{
+			name:          "Non-transient network error is not retried",
+			policy:        policy,
+			responses:     []stubResponse{{err: errors.New("x509: certificate signed by unknown authority")}},
+			expectedCalls: 1,
+			wantErr:       true,
+		},
+		{
+			name:          "OK_RetriedTooManyRequests",
+			policy:        policy,
+			responses:     []stubResponse{{statusCode: 429}, ok},
+			expectedCalls: 2,
+			wantErr:       false,
+		},
+		{
+			name:      "OK_RetriedAddWithOptIn",
+			policy:    RetryPolicy{MaxAttempts: 3, RetryNonIdempotent: true},
+			responses: []stubResponse{{statusCode: 503}, ok},
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.Add(ctx, AddInput{AccessToken: "access-token", URL: "https://github.com"})
+				return err
+			},
+			expectedCalls: 2,
+			wantErr:       false,
+		},
+		{
+			name:          "Attempts exhausted",
+			policy:        policy,
+			responses:     []stubResponse{{statusCode: 503}, {statusCode: 502}, {statusCode: 500}},
+			expectedCalls: 3,
+			wantErr:       true,
+		},
+		{
+			name:          "Client error is not retried",
+			policy:        policy,
+			responses:     []stubResponse{{statusCode: 400}},
+			expectedCalls: 1,
+			wantErr:       true,
+		},
+		{
+			name:          "Retries disabled by default",
+			responses:     []stubResponse{{statusCode: 503}},
+			expectedCalls: 1,
+			wantErr:       true,
+		},
+		{
+			name:      "Add is not retried",
+			policy:    policy,
+			responses: []stubResponse{{statusCode: 503}},
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.Add(ctx, AddInput{AccessToken: "access-token", URL: "https://github.com"})
+				return err
+			},
+			expectedCalls: 1,
+			wantErr:       true,
+		},
+		{
+			name:   "Rate limit reset after MaxBackoff",
+			policy: policy,
+			responses: []stubResponse{{
+				statusCode: 403,
+				header:     http.Header{xLimitUserRemainingHeader: {"0"}, xLimitUserResetHeader: {"3600"}},
+			}},
+			expectedCalls: 1,
+			wantErr:       true,
+		},
+		{
+			name:   "Rate limit reset after the context deadline",
+			policy: policy,
+			responses: []stubResponse{{
+				statusCode: 403,
+				header:     http.Header{xLimitUserRemainingHeader: {"0"}, xLimitUserResetHeader: {"3600"}},
+			}},
+			timeout:       time.Second,
+			expectedCalls: 1,
+			wantErr:       true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var calls int
+			client := newTestClient(t, scriptedTransport(&calls, tc.responses...), WithRetryPolicy(tc.policy))
+
+			ctx := context.Background()
+			if tc.timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tc.timeout)
+				defer cancel()
+			}
+
+			call := tc.call
+			if call == nil {
+				call = func(ctx context.Context, c *Client) error {
+					_, err := c.Retrieving(ctx, RetrievingInput{AccessToken: "access-token"})
+					return err
+				}
+			}
+
+			err := call(ctx, client)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectedCalls, calls)
+		})
+	}
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	testCases := []struct {
+		name     string
+		policy   RetryPolicy
+		retry    int
+		expected time.Duration
+	}{
+		{
+			name:     "First retry",
+			policy:   RetryPolicy{MinBackoff: time.Second, MaxBackoff: time.Minute},
+			retry:    1,
+			expected: time.Second,
+		},
+		{
+			name:     "Doubled",
+			policy:   RetryPolicy{MinBackoff: time.Second, MaxBackoff: time.Minute},
+			retry:    4,
+			expected: 8 * time.Second,
+		},
+		{
+			name:     "Limited by MaxBackoff",
+			policy:   RetryPolicy{MinBackoff: time.Second, MaxBackoff: 5 * time.Second},
+			retry:    4,
+			expected: 5 * time.Second,
+		},
+		{
+			name:     "No MaxBackoff",
+			policy:   RetryPolicy{MinBackoff: time.Second},
+			retry:    4,
+			expected: 8 * time.Second,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := tc.policy.backoff(tc.retry)
+			assert.GreaterOrEqual(t, int64(got), int64(tc.expected/2))
+			assert.LessOrEqual(t, int64(got), int64(tc.expected))
+		})
+	}
+}
+
+func TestRetryPolicy_delay_RateLimited(t *testing.T) {
+	err := &APIError{
+		StatusCode: http.StatusForbidden,
+		Header:     http.Header{xLimitUserRemainingHeader: {"0"}, xLimitUserResetHeader: {"3600"}},
+	}
+
+	_, ok := DefaultRetryPolicy.delay(1, err)
+	assert.False(t, ok)
+
+	delay, ok := RetryPolicy{MinBackoff: time.Second}.delay(1, err)
+	assert.True(t, ok)
+	assert.Equal(t, time.Hour, delay)
+}
+
+func TestClient_Retry_UnsupportedScheme(t *testing.T) {
+	var calls int
+	countAttempts := func(next Handler) Handler {
+		return func(ctx context.Context, req *Request) (*Response, error) {
+			calls++
+			return next(ctx, req)
+		}
+	}
+
+	client, err := NewClient("consumer-key",
+		WithBaseURL("ftp://localhost"),
+		WithRetryPolicy(RetryPolicy{MaxAttempts: 4}),
+		WithMiddleware(countAttempts),
+	)
+	assert.NoError(t, err)
+
+	_, err = client.Retrieving(context.Background(), RetrievingInput{AccessToken: "access-token"})
+	assert.Error(t, err)
+	assert.Equal(t, 1, calls)
+}
@@ -0,0 +1,96 @@
+package go_pocket_sdk
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	itemStatusArchived = "1"
+	itemStatusDeleted  = "2"
+)
+
+// ChangeSet contains the changes of the Pocket list since the previous synchronization
+type ChangeSet struct {
+	// Added contains unread items added after the previous synchronization
+	Added []Item
+	// Updated contains unread items added before the previous synchronization and changed after it
+	Updated []Item
+	// Archived contains archived items
+	Archived []Item
+	// Deleted contains deleted items (only their ID and Status are filled)
+	Deleted []Item
+	// Since is the new cursor to persist and pass to the next synchronization
+	Since time.Time
+}
+
+// Empty reports whether there are no changes
+func (cs ChangeSet) Empty() bool {
+	return len(cs.Added)+len(cs.Updated)+len(cs.Archived)+len(cs.Deleted) == 0
+}
+
+// Syncer incrementally synchronizes the Pocket list of a user using the since parameter of the Retrieve API
+type Syncer struct {
+	client      *Client
+	accessToken string
+	since       time.Time
+}
+
+// NewSyncer creates a new Syncer for the user with a cursor saved after the previous synchronization (zero time for the first one)
+func (c *Client) NewSyncer(accessToken string, since time.Time) *Syncer {
+	return &Syncer{
+		client:      c,
+		accessToken: accessToken,
+		since:       since,
+	}
+}
+
+// Since returns the current cursor
+func (s *Syncer) Since() time.Time {
+	return s.since
+}
+
+// Sync retrieves the changes since the current cursor and advances the cursor on success
+func (s *Syncer) Sync(ctx context.Context) (ChangeSet, error) {
+	input := RetrievingInput{
+		AccessToken: s.accessToken,
+		State:       StateAll,
+		DetailType:  DetailTypeComplete,
+		Since:       s.since,
+	}
+
+	result, err := s.client.Get(ctx, input)
+	if err != nil {
+		return ChangeSet{}, err
+	}
+
+	changes := s.classify(result.Items)
+
+	changes.Since = result.Since
+	if changes.Since.IsZero() {
+		changes.Since = s.since
+	}
+	s.since = changes.Since
+
+	return changes, nil
+}
+
+func (s *Syncer) classify(items []Item) ChangeSet {
+	var changes ChangeSet
+	for _, item := range items {
+		switch item.Status {
+		case itemStatusDeleted:
+			changes.Deleted = append(changes.Deleted, item)
+		case itemStatusArchived:
+			changes.Archived = append(changes.Archived, item)
+		default:
+			if s.since.IsZero() || !item.TimeAdded.Before(s.since) {
+				changes.Added = append(changes.Added, item)
+			} else {
+				changes.Updated = append(changes.Updated, item)
+			}
+		}
+	}
+
+	return changes
+}
@@ -0,0 +1,78 @@
+package go_pocket_sdk
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncer_Sync(t *testing.T) {
+	responses := []string{
+		`{"status":1,"complete":1,"since":1000,"list":{"1":{"item_id":"1","status":"0","time_added":"900","sort_id":0},"2":{"item_id":"2","status":"1","time_added":"800","sort_id":1}}}`,
+		`{"status":1,"since":1800,"list":{"1":{"item_id":"1","status":"0","time_added":"900","sort_id":0}}}`,
+		`{"status":1,"complete":0,"since":2000,"list":{"3":{"item_id":"3","status":"0","time_added":"1900","sort_id":1},"2":{"item_id":"2","status":"2","sort_id":2}}}`,
+		`{"status":2,"list":[]}`,
+	}
+
+	var requests []requestRetrieving
+	client := newTestClient(t, func(r *http.Request) (*http.Response, error) {
+		b, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Contains(t, string(b), `"detailType":"complete"`)
+
+		var req requestRetrieving
+		assert.NoError(t, json.Unmarshal(b, &req))
+		body := responses[len(requests)]
+		requests = append(requests, req)
+
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}, nil
+	})
+
+	syncer := client.NewSyncer("access-token", time.Time{})
+
+	changes, err := syncer.Sync(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "all", requests[0].State)
+	assert.Equal(t, "complete", requests[0].DetailType)
+	assert.Equal(t, int64(0), requests[0].Since)
+	assert.Equal(t, []string{"1"}, itemIDs(changes.Added))
+	assert.Equal(t, []string{"2"}, itemIDs(changes.Archived))
+	assert.Empty(t, changes.Updated)
+	assert.Empty(t, changes.Deleted)
+	assert.Equal(t, time.Unix(1000, 0).UTC(), changes.Since)
+
+	changes, err = syncer.Sync(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1000), requests[1].Since)
+	assert.Equal(t, []string{"1"}, itemIDs(changes.Updated))
+	assert.Equal(t, time.Unix(1800, 0).UTC(), syncer.Since())
+
+	// the cursor does not depend on the undocumented complete field
+	changes, err = syncer.Sync(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1800), requests[2].Since)
+	assert.Equal(t, []string{"3"}, itemIDs(changes.Added))
+	assert.Empty(t, changes.Updated)
+	assert.Equal(t, []string{"2"}, itemIDs(changes.Deleted))
+	assert.Equal(t, time.Unix(2000, 0).UTC(), syncer.Since())
+
+	changes, err = syncer.Sync(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, changes.Empty())
+	assert.Equal(t, time.Unix(2000, 0).UTC(), changes.Since)
+}
+
+func itemIDs(items []Item) []string {
+	var ids []string
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+
+	return ids
+}
@@ -0,0 +1,316 @@
+package go_pocket_sdk
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+const (
+	tokenFileMode       = 0600
+	tokenSaltSize       = 16
+	tokenKeyIterations  = 100000
+	tokenEncryptedMagic = "PKTS1"
+)
+
+// TokenStore persists authorizations of users, so a returning user does not have to go through the OAuth flow again
+type TokenStore interface {
+	// Get returns the authorization saved for the key or ErrTokenNotFound
+	Get(ctx context.Context, key string) (Authorization, error)
+	// Put saves the authorization for the key, replacing the previous one
+	Put(ctx context.Context, key string, auth Authorization) error
+	// Delete removes the authorization saved for the key, it is not an error if there is none
+	Delete(ctx context.Context, key string) error
+}
+
+// MemoryTokenStore is a TokenStore that keeps authorizations in memory, it is safe for concurrent use
+type MemoryTokenStore struct {
+	mu     sync.RWMutex
+	tokens map[string]Authorization
+}
+
+// NewMemoryTokenStore creates an empty MemoryTokenStore
+func NewMemoryTokenStore() *MemoryTokenStore {
+	return &MemoryTokenStore{tokens: make(map[string]Authorization)}
+}
+
+func (s *MemoryTokenStore) Get(_ context.Context, key string) (Authorization, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	auth, ok := s.tokens[key]
+	if !ok {
+		return Authorization{}, ErrTokenNotFound
+	}
+
+	return auth, nil
+}
+
+func (s *MemoryTokenStore) Put(_ context.Context, key string, auth Authorization) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.tokens[key] = auth
+	return nil
+}
+
+func (s *MemoryTokenStore) Delete(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.tokens, key)
+	return nil
+}
+
+// FileTokenStore is a TokenStore that keeps authorizations in a JSON file readable only by its owner (0600).
+// If a passphrase is set, the file is encrypted with AES-GCM using a key derived from the passphrase.
+// It is safe for concurrent use within one process
+type FileTokenStore struct {
+	mu         sync.Mutex
+	path       string
+	passphrase string
+}
+
+// NewFileTokenStore creates a FileTokenStore that keeps authorizations in plain JSON at the path
+func NewFileTokenStore(path string) *FileTokenStore {
+	return &FileTokenStore{path: path}
+}
+
+// NewEncryptedFileTokenStore creates a FileTokenStore that keeps authorizations encrypted with the passphrase at the path
+func NewEncryptedFileTokenStore(path, passphrase string) *FileTokenStore {
+	return &FileTokenStore{path: path, passphrase: passphrase}
+}
+
+func (s *FileTokenStore) Get(_ context.Context, key string) (Authorization, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tokens, err := s.load()
+	if err != nil {
+		return Authorization{}, err
+	}
+
+	auth, ok := tokens[key]
+	if !ok {
+		return Authorization{}, ErrTokenNotFound
+	}
+
+	return auth, nil
+}
+
+func (s *FileTokenStore) Put(_ context.Context, key string, auth Authorization) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tokens, err := s.load()
+	if err != nil {
+		return err
+	}
+
+	tokens[key] = auth
+	return s.save(tokens)
+}
+
+func (s *FileTokenStore) Delete(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tokens, err := s.load()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := tokens[key]; !ok {
+		return nil
+	}
+
+	delete(tokens, key)
+	return s.save(tokens)
+}
+
+func (s *FileTokenStore) load() (map[string]Authorization, error) {
+	tokens := make(map[string]Authorization)
+
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return tokens, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error occurred when reading the token file: %w", err)
+	}
+
+	if s.passphrase != "" {
+		if data, err = decryptTokens(data, s.passphrase); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = json.Unmarshal(data, &tokens); err != nil {
+		return nil, fmt.Errorf("error occurred when parsing the token file: %w", err)
+	}
+
+	return tokens, nil
+}
+
+// save writes the tokens to a temporary file and renames it, so the token file is never left partially written
+func (s *FileTokenStore) save(tokens map[string]Authorization) error {
+	data, err := json.Marshal(tokens)
+	if err != nil {
+		return fmt.Errorf("error occurred when marshal the tokens: %w", err)
+	}
+
+	if s.passphrase != "" {
+		if data, err = encryptTokens(data, s.passphrase); err != nil {
+			return err
+		}
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".tmp*")
+	if err != nil {
+		return fmt.Errorf("error occurred when writing the token file: %w", err)
+	}
+	defer os.Remove(f.Name())
+
+	if err = f.Chmod(tokenFileMode); err == nil {
+		_, err = f.Write(data)
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), s.path)
+	}
+	if err != nil {
+		return fmt.Errorf("error occurred when writing the token file: %w", err)
+	}
+
+	return nil
+}
+
+// encryptTokens returns magic | salt | nonce | AES-GCM ciphertext
+func encryptTokens(data []byte, passphrase string) ([]byte, error) {
+	salt := make([]byte, tokenSaltSize)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return nil, fmt.Errorf("error occurred when encrypting the tokens: %w", err)
+	}
+
+	gcm, err := newTokenCipher(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("error occurred when encrypting the tokens: %w", err)
+	}
+
+	out := append([]byte(tokenEncryptedMagic), salt...)
+	out = append(out, nonce...)
+	return gcm.Seal(out, nonce, data, []byte(tokenEncryptedMagic)), nil
+}
+
+func decryptTokens(data []byte, passphrase string) ([]byte, error) {
+	if len(data) < len(tokenEncryptedMagic)+tokenSaltSize || string(data[:len(tokenEncryptedMagic)]) != tokenEncryptedMagic {
+		return nil, ErrTokenFileDecryption
+	}
+	data = data[len(tokenEncryptedMagic):]
+
+	gcm, err := newTokenCipher(passphrase, data[:tokenSaltSize])
+	if err != nil {
+		return nil, err
+	}
+	data = data[tokenSaltSize:]
+
+	if len(data) < gcm.NonceSize() {
+		return nil, ErrTokenFileDecryption
+	}
+
+	plain, err := gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], []byte(tokenEncryptedMagic))
+	if err != nil {
+		return nil, ErrTokenFileDecryption
+	}
+
+	return plain, nil
+}
+
+func newTokenCipher(passphrase string, salt []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(deriveKey(passphrase, salt))
+	if err != nil {
+		return nil, fmt.Errorf("error occurred when creating the cipher: %w", err)
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// deriveKey derives a 256-bit key from the passphrase with PBKDF2-HMAC-SHA256 (a single block is enough for the key size)
+func deriveKey(passphrase string, salt []byte) []byte {
+	prf := hmac.New(sha256.New, []byte(passphrase))
+	prf.Write(salt)
+	prf.Write([]byte{0, 0, 0, 1})
+	u := prf.Sum(nil)
+
+	key := make([]byte, len(u))
+	copy(key, u)
+
+	for i := 1; i < tokenKeyIterations; i++ {
+		prf.Reset()
+		prf.Write(u)
+		u = prf.Sum(u[:0])
+		for j := range key {
+			key[j] ^= u[j]
+		}
+	}
+
+	return key
+}
+
+// AuthorizeWithStore returns the authorization saved in the store for the key.
+// If there is none, it performs the authorization with the function and saves the result, so a returning user skips the OAuth flow.
+// If the saved access token is revoked (see IsAuthError), delete it from the store and call AuthorizeWithStore again
+func AuthorizeWithStore(ctx context.Context, store TokenStore, key string, authorize func(ctx context.Context) (Authorization, error)) (Authorization, error) {
+	auth, err := store.Get(ctx, key)
+	if err == nil {
+		return auth, nil
+	}
+	if !errors.Is(err, ErrTokenNotFound) {
+		return Authorization{}, err
+	}
+
+	if auth, err = authorize(ctx); err != nil {
+		return Authorization{}, err
+	}
+
+	if err = store.Put(ctx, key, auth); err != nil {
+		return Authorization{}, err
+	}
+
+	return auth, nil
+}
+
+// AuthorizeLocal returns the authorization saved in the store for the key, or performs the authorization with StartLocalAuth,
+// passing the authorization URL to open (for example, to print it or to open a browser) and saving the result
+func (c *Client) AuthorizeLocal(ctx context.Context, store TokenStore, key string, open func(authURL string) error) (Authorization, error) {
+	return AuthorizeWithStore(ctx, store, key, func(ctx context.Context) (Authorization, error) {
+		flow, err := c.StartLocalAuth(ctx)
+		if err != nil {
+			return Authorization{}, err
+		}
+		defer flow.Close()
+
+		if err = open(flow.AuthorizationURL()); err != nil {
+			return Authorization{}, err
+		}
+
+		return flow.Wait(ctx)
+	})
+}
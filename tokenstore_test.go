@@ -0,0 +1,103 @@
+package go_pocket_sdk
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenStores(t *testing.T) {
+	dir := t.TempDir()
+
+	stores := map[string]TokenStore{
+		"Memory":        NewMemoryTokenStore(),
+		"File":          NewFileTokenStore(filepath.Join(dir, "tokens.json")),
+		"EncryptedFile": NewEncryptedFileTokenStore(filepath.Join(dir, "tokens.enc"), "passphrase"),
+	}
+
+	for name, store := range stores {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			auth := Authorization{AccessToken: "access-token", Username: "pocket-user"}
+
+			_, err := store.Get(ctx, "pocket-user")
+			assert.ErrorIs(t, err, ErrTokenNotFound)
+
+			assert.NoError(t, store.Put(ctx, "pocket-user", auth))
+			assert.NoError(t, store.Put(ctx, "other-user", Authorization{AccessToken: "other-token"}))
+
+			got, err := store.Get(ctx, "pocket-user")
+			assert.NoError(t, err)
+			assert.Equal(t, auth, got)
+
+			assert.NoError(t, store.Delete(ctx, "pocket-user"))
+			assert.NoError(t, store.Delete(ctx, "pocket-user"))
+
+			_, err = store.Get(ctx, "pocket-user")
+			assert.ErrorIs(t, err, ErrTokenNotFound)
+
+			got, err = store.Get(ctx, "other-user")
+			assert.NoError(t, err)
+			assert.Equal(t, "other-token", got.AccessToken)
+		})
+	}
+}
+
+func TestFileTokenStore_File(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	plainPath := filepath.Join(dir, "tokens.json")
+	assert.NoError(t, NewFileTokenStore(plainPath).Put(ctx, "pocket-user", Authorization{AccessToken: "access-token"}))
+
+	info, err := os.Stat(plainPath)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(tokenFileMode), info.Mode().Perm())
+
+	data, err := os.ReadFile(plainPath)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), "access-token")
+
+	encryptedPath := filepath.Join(dir, "tokens.enc")
+	assert.NoError(t, NewEncryptedFileTokenStore(encryptedPath, "passphrase").Put(ctx, "pocket-user", Authorization{AccessToken: "access-token"}))
+
+	data, err = os.ReadFile(encryptedPath)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), "access-token")
+
+	_, err = NewEncryptedFileTokenStore(encryptedPath, "wrong-passphrase").Get(ctx, "pocket-user")
+	assert.ErrorIs(t, err, ErrTokenFileDecryption)
+
+	got, err := NewEncryptedFileTokenStore(encryptedPath, "passphrase").Get(ctx, "pocket-user")
+	assert.NoError(t, err)
+	assert.Equal(t, "access-token", got.AccessToken)
+}
+
+func TestAuthorizeWithStore(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryTokenStore()
+
+	var calls int
+	authorize := func(ctx context.Context) (Authorization, error) {
+		calls++
+		return Authorization{AccessToken: "access-token", Username: "pocket-user"}, nil
+	}
+
+	for i := 0; i < 2; i++ {
+		auth, err := AuthorizeWithStore(ctx, store, "pocket-user", authorize)
+		assert.NoError(t, err)
+		assert.Equal(t, "access-token", auth.AccessToken)
+	}
+	assert.Equal(t, 1, calls)
+
+	_, err := AuthorizeWithStore(ctx, store, "other-user", func(ctx context.Context) (Authorization, error) {
+		return Authorization{}, ErrEmptyAccessToken
+	})
+	assert.ErrorIs(t, err, ErrEmptyAccessToken)
+
+	_, err = store.Get(ctx, "other-user")
+	assert.ErrorIs(t, err, ErrTokenNotFound)
+}
@@ -0,0 +1,100 @@
+package go_pocket_sdk
+
+import (
+	"context"
+	"sort"
+)
+
+// UserClient is a getpocket API client bound to the access token of a user, so it does not have to be passed to each method
+type UserClient struct {
+	client *Client
+	auth   Authorization
+}
+
+// ForUser returns a client that makes requests on behalf of the authorized user
+func (c *Client) ForUser(auth Authorization) *UserClient {
+	return &UserClient{
+		client: c,
+		auth:   auth,
+	}
+}
+
+// Username returns the name of the user the client is bound to
+func (u *UserClient) Username() string {
+	return u.auth.Username
+}
+
+// Add creates a new item in the Pocket list of the user (input.AccessToken is ignored)
+func (u *UserClient) Add(ctx context.Context, input AddInput) (Item, error) {
+	input.AccessToken = u.auth.AccessToken
+	return u.client.Add(ctx, input)
+}
+
+// Modify performs the actions on the Pocket list of the user
+func (u *UserClient) Modify(ctx context.Context, actions ...Action) (ModifyResult, error) {
+	return u.client.Modify(ctx, ModifyInput{
+		AccessToken: u.auth.AccessToken,
+		Actions:     actions,
+	})
+}
+
+// Retrieve retrieves items of the user together with the metadata of the response (input.AccessToken is ignored)
+func (u *UserClient) Retrieve(ctx context.Context, input RetrievingInput) (RetrieveResult, error) {
+	input.AccessToken = u.auth.AccessToken
+	return u.client.Get(ctx, input)
+}
+
+// Items returns an iterator over all items of the user matching the input (input.AccessToken is ignored)
+func (u *UserClient) Items(ctx context.Context, input RetrievingInput, opts ...IteratorOption) *ItemIterator {
+	input.AccessToken = u.auth.AccessToken
+	return u.client.Items(ctx, input, opts...)
+}
+
+// Archive moves the items to the archive
+func (u *UserClient) Archive(ctx context.Context, itemIDs ...string) (ModifyResult, error) {
+	return u.Modify(ctx, newActions(NewArchiveAction, itemIDs)...)
+}
+
+// Favorite marks the items as favorites
+func (u *UserClient) Favorite(ctx context.Context, itemIDs ...string) (ModifyResult, error) {
+	return u.Modify(ctx, newActions(NewFavoriteAction, itemIDs)...)
+}
+
+// Delete permanently deletes the items
+func (u *UserClient) Delete(ctx context.Context, itemIDs ...string) (ModifyResult, error) {
+	return u.Modify(ctx, newActions(NewDeleteAction, itemIDs)...)
+}
+
+// Tags returns all tags used by the user, sorted alphabetically.
+// Pocket has no API for tags, so all items of the user are retrieved to collect them
+func (u *UserClient) Tags(ctx context.Context) ([]string, error) {
+	it := u.Items(ctx, RetrievingInput{State: StateAll, DetailType: DetailTypeComplete})
+
+	seen := make(map[string]struct{})
+	for it.Next() {
+		for _, tag := range it.Item().Tags {
+			seen[tag] = struct{}{}
+		}
+	}
+
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	tags := make([]string, 0, len(seen))
+	for tag := range seen {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+
+	return tags, nil
+}
+
+func newActions(newAction func(itemID string) Action, itemIDs []string) []Action {
+	actions := make([]Action, 0, len(itemIDs))
+	for _, itemID := range itemIDs {
+		actions = append(actions, newAction(itemID))
+	}
+
+	return actions
+}
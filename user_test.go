@@ -0,0 +1,61 @@
+package go_pocket_sdk
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserClient(t *testing.T) {
+	responses := map[string]string{
+		endpointAdd:        `{"status":1,"item":{"item_id":"1"}}`,
+		endpointModify:     `{"status":1,"action_results":[true,true]}`,
+		endpointRetrieving: `{"status":1,"list":{"1":{"item_id":"1","sort_id":0,"tags":{"go":{},"sdk":{}}},"2":{"item_id":"2","sort_id":1,"tags":{"api":{},"go":{}}}}}`,
+	}
+
+	var bodies []map[string]interface{}
+	client := newTestClient(t, func(r *http.Request) (*http.Response, error) {
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		bodies = append(bodies, body)
+
+		respBody := responses[r.URL.Path]
+		if body["offset"] != nil {
+			respBody = `{"status":2,"list":[]}`
+		}
+
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(respBody))}, nil
+	})
+
+	user := client.ForUser(Authorization{AccessToken: "access-token", Username: "pocket-user"})
+	assert.Equal(t, "pocket-user", user.Username())
+
+	item, err := user.Add(context.Background(), AddInput{URL: "https://github.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, "1", item.ID)
+
+	result, err := user.Archive(context.Background(), "1", "2")
+	assert.NoError(t, err)
+	assert.NoError(t, result.Err())
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"action": ActionArchive, "item_id": "1"},
+		map[string]interface{}{"action": ActionArchive, "item_id": "2"},
+	}, bodies[1]["actions"])
+
+	_, err = user.Favorite(context.Background())
+	assert.ErrorIs(t, err, ErrNoActions)
+
+	tags, err := user.Tags(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"api", "go", "sdk"}, tags)
+	assert.Equal(t, string(DetailTypeComplete), bodies[2]["detailType"])
+
+	for _, body := range bodies {
+		assert.Equal(t, "access-token", body["access_token"])
+	}
+}